@@ -0,0 +1,199 @@
+package gogl
+
+import "unsafe"
+
+// Backend is the set of OpenGL entry points used by gogl. Every exported
+// function of this package forwards to the Backend that is currently in use,
+// which defaults to the one returned by NewGLBackend.
+//
+// A Backend can be replaced with InitWithBackend, e.g., to run rendering code
+// against a fake in unit tests or against bindings of another OpenGL version.
+//
+// The methods closely follow the OpenGL functions of the same name. Functions
+// that generate or delete object names work on a single object, and functions
+// that return values through pointers return them directly or fill the passed
+// slice instead.
+type Backend interface {
+	// Init loads the OpenGL function pointers from the active OpenGL context.
+	Init() error
+	// GetString returns a string describing the current GL connection.
+	GetString(name GLEnum) string
+	// GetError returns error information.
+	GetError() GLEnum
+
+	// Buffers
+
+	BindBuffer(target GLEnum, buffer Buffer)
+	BufferData(target GLEnum, size int, data unsafe.Pointer, usage GLEnum)
+	BufferSubData(target GLEnum, offset, size int, data unsafe.Pointer)
+	CreateBuffer() Buffer
+	DeleteBuffer(buffer Buffer)
+	GetBufferParameteri(target, pname GLEnum) int32
+	IsBuffer(buffer Buffer) bool
+
+	// State information
+
+	ActiveTexture(texture GLEnum)
+	BlendColor(red, green, blue, alpha float32)
+	BlendEquation(mode GLEnum)
+	BlendEquationSeparate(modeRGB, modeAlpha GLEnum)
+	BlendFunc(sfactor, dfactor GLEnum)
+	BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLEnum)
+	ClearColor(red, green, blue, alpha float32)
+	ClearDepth(depth float32)
+	ClearStencil(s int32)
+	ColorMask(red, green, blue, alpha bool)
+	CullFace(mode GLEnum)
+	DepthFunc(xfunc GLEnum)
+	DepthMask(flag bool)
+	DepthRange(zNear, zFar float32)
+	Disable(cap GLEnum)
+	Enable(cap GLEnum)
+	FrontFace(mode GLEnum)
+	GetBooleanv(pname GLEnum, data []bool)
+	GetFloatv(pname GLEnum, data []float32)
+	GetIntegerv(pname GLEnum, data []int32)
+	Hint(target, mode GLEnum)
+	IsEnabled(cap GLEnum) bool
+	LineWidth(width float32)
+	PixelStorei(pname GLEnum, param int32)
+	PolygonOffset(factor, units float32)
+	SampleCoverage(value float32, invert bool)
+	StencilFunc(xfunc GLEnum, ref int32, mask uint32)
+	StencilFuncSeparate(face, xfunc GLEnum, ref int32, mask uint32)
+	StencilMask(mask uint32)
+	StencilMaskSeparate(face GLEnum, mask uint32)
+	StencilOp(fail, zfail, zpass GLEnum)
+	StencilOpSeparate(face, fail, zfail, zpass GLEnum)
+
+	// Drawing buffers
+
+	Clear(mask GLEnum)
+	DrawArrays(mode GLEnum, first, count int32)
+	DrawArraysInstanced(mode GLEnum, first, count, instanceCount int32)
+	DrawElements(mode GLEnum, count int32, xtype GLEnum, offset int)
+	DrawElementsInstanced(mode GLEnum, count int32, xtype GLEnum, offset int, instanceCount int32)
+	Finish()
+	Flush()
+
+	// Framebuffers
+
+	BindFramebuffer(target GLEnum, framebuffer Framebuffer)
+	BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int32, mask, filter GLEnum)
+	CheckFramebufferStatus(target GLEnum) GLEnum
+	CreateFramebuffer() Framebuffer
+	DeleteFramebuffer(framebuffer Framebuffer)
+	DrawBuffer(mode GLEnum)
+	DrawBuffers(buffers []GLEnum)
+	FramebufferRenderbuffer(target, attachment, renderbuffertarget GLEnum, renderbuffer Renderbuffer)
+	FramebufferTexture2D(target, attachment, textarget GLEnum, texture Texture, level int32)
+	GetFramebufferAttachmentParameteri(target, attachment, pname GLEnum) int32
+	IsFramebuffer(framebuffer Framebuffer) bool
+	ReadBuffer(mode GLEnum)
+	ReadPixels(x, y, width, height int32, format, xtype GLEnum, pixels unsafe.Pointer)
+
+	// Programs and shaders
+
+	AttachShader(program Program, shader Shader)
+	BindAttribLocation(program Program, index uint32, name string)
+	CompileShader(shader Shader)
+	CreateProgram() Program
+	CreateShader(xtype GLEnum) Shader
+	DeleteProgram(program Program)
+	DeleteShader(shader Shader)
+	DetachShader(program Program, shader Shader)
+	GetProgrami(program Program, pname GLEnum) int32
+	GetProgramInfoLog(program Program) string
+	GetShaderi(shader Shader, pname GLEnum) int32
+	GetShaderInfoLog(shader Shader) string
+	IsProgram(program Program) bool
+	IsShader(shader Shader) bool
+	LinkProgram(program Program)
+	ShaderSource(shader Shader, source string)
+	UseProgram(program Program)
+	ValidateProgram(program Program)
+
+	// Renderbuffers
+
+	BindRenderbuffer(target GLEnum, renderbuffer Renderbuffer)
+	CreateRenderbuffer() Renderbuffer
+	DeleteRenderbuffer(renderbuffer Renderbuffer)
+	GetRenderbufferParameteri(target, pname GLEnum) int32
+	IsRenderbuffer(renderbuffer Renderbuffer) bool
+	RenderbufferStorage(target, internalFormat GLEnum, width, height int32)
+	RenderbufferStorageMultisample(target GLEnum, samples int32, internalFormat GLEnum, width, height int32)
+
+	// Textures
+
+	BindTexture(target GLEnum, texture Texture)
+	CompressedTexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border, imageSize int32, pixels unsafe.Pointer)
+	CompressedTexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format GLEnum, imageSize int32, pixels unsafe.Pointer)
+	CopyTexImage2D(target GLEnum, level int32, internalformat GLEnum, x, y, width, height, border int32)
+	CopyTexSubImage2D(target GLEnum, level, xoffset, yoffset, x, y, width, height int32)
+	CreateTexture() Texture
+	DeleteTexture(texture Texture)
+	GenerateMipmap(target GLEnum)
+	IsTexture(texture Texture) bool
+	TexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border int32, format, xtype GLEnum, pixels unsafe.Pointer)
+	TexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format, xtype GLEnum, pixels unsafe.Pointer)
+	TexParameterf(target, pname GLEnum, param float32)
+	TexParameteri(target, pname GLEnum, param int32)
+
+	// Uniforms and attributes
+
+	DisableVertexAttribArray(index uint32)
+	EnableVertexAttribArray(index uint32)
+	GetActiveAttrib(program Program, index uint32) (name string, size int32, xtype GLEnum)
+	GetActiveUniform(program Program, index uint32) (name string, size int32, xtype GLEnum)
+	GetAttribLocation(program Program, name string) int32
+	GetUniformLocation(program Program, name string) UniformLocation
+	Uniform1f(location UniformLocation, v0 float32)
+	Uniform1fv(location UniformLocation, count int32, value []float32)
+	Uniform1i(location UniformLocation, v0 int32)
+	Uniform1iv(location UniformLocation, count int32, value []int32)
+	Uniform2f(location UniformLocation, v0, v1 float32)
+	Uniform2fv(location UniformLocation, count int32, value []float32)
+	Uniform2i(location UniformLocation, v0, v1 int32)
+	Uniform2iv(location UniformLocation, count int32, value []int32)
+	Uniform3f(location UniformLocation, v0, v1, v2 float32)
+	Uniform3fv(location UniformLocation, count int32, value []float32)
+	Uniform3i(location UniformLocation, v0, v1, v2 int32)
+	Uniform3iv(location UniformLocation, count int32, value []int32)
+	Uniform4f(location UniformLocation, v0, v1, v2, v3 float32)
+	Uniform4fv(location UniformLocation, count int32, value []float32)
+	Uniform4i(location UniformLocation, v0, v1, v2, v3 int32)
+	Uniform4iv(location UniformLocation, count int32, value []int32)
+	UniformMatrix2fv(location UniformLocation, count int32, transpose bool, value []float32)
+	UniformMatrix3fv(location UniformLocation, count int32, transpose bool, value []float32)
+	UniformMatrix4fv(location UniformLocation, count int32, transpose bool, value []float32)
+	VertexAttrib1f(index uint32, v0 float32)
+	VertexAttrib2f(index uint32, v0, v1 float32)
+	VertexAttrib3f(index uint32, v0, v1, v2 float32)
+	VertexAttrib4f(index uint32, v0, v1, v2, v3 float32)
+	VertexAttrib1fv(index uint32, value []float32)
+	VertexAttrib2fv(index uint32, value []float32)
+	VertexAttrib3fv(index uint32, value []float32)
+	VertexAttrib4fv(index uint32, value []float32)
+	VertexAttribDivisor(index, divisor uint32)
+	VertexAttribPointer(index uint32, size int32, xtype GLEnum, normalized bool, stride int32, offset int)
+
+	// Vertex arrays
+
+	BindVertexArray(array VertexArray)
+	CreateVertexArray() VertexArray
+	DeleteVertexArray(array VertexArray)
+	IsVertexArray(array VertexArray) bool
+
+	// Viewing and clipping
+
+	Scissor(x, y, width, height int32)
+	Viewport(x, y, width, height int32)
+}
+
+// backend is the Backend all exported functions of this package forward to.
+var backend Backend = NewGLBackend()
+
+// CurrentBackend returns the Backend that is currently in use.
+func CurrentBackend() Backend {
+	return backend
+}
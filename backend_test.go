@@ -0,0 +1,22 @@
+package gogl_test
+
+import (
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/gogltest"
+)
+
+// initBackend initializes the package with a fake Backend reporting the
+// OpenGL version and extensions, and returns it without any recorded calls.
+func initBackend(t *testing.T, version, extensions string) *gogltest.Backend {
+	t.Helper()
+	b := gogltest.NewBackend()
+	b.Strings[gogl.GLVersion] = version
+	b.Strings[gogl.GLExtensions] = extensions
+	if err := gogl.InitWithBackend(b); err != nil {
+		t.Fatalf("InitWithBackend() error = %v", err)
+	}
+	b.ClearCalls()
+	return b
+}
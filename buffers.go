@@ -1,6 +1,9 @@
 package gogl
 
 import (
+	"fmt"
+	"reflect"
+	"sync"
 	"unsafe"
 
 	"github.com/go-gl/gl/v2.1/gl"
@@ -8,50 +11,132 @@ import (
 
 // BindBuffer binds a given Buffer to a target.
 func BindBuffer(target GLEnum, buffer Buffer) {
-	gl.BindBuffer(uint32(target), uint32(buffer))
+	if target == GLElementArrayBuffer {
+		if state := recordingVertexArray(); state != nil {
+			state.elementBuffer = buffer
+		}
+	}
+	backend.BindBuffer(target, buffer)
 }
 
-// BufferData initializes and creates the buffer object's data store.
-func BufferData(target GLEnum, srcData []float32, usage GLEnum) {
-	gl.BufferData(uint32(target), len(srcData)*4, unsafe.Pointer(&srcData[0]), uint32(usage))
+// BufferData initializes and creates the buffer object's data store, and fills
+// it with srcData.
+//
+// The elements of srcData can be of any type of fixed size without pointers,
+// e.g., float32 vertex data, uint16 or uint32 indices, uint8 colors, or
+// structs of interleaved vertex attributes. Their size, including padding,
+// determines the size of the data store. An empty srcData creates an empty
+// data store. BufferData panics if the element type contains pointers,
+// strings, slices, maps, channels, functions or interfaces.
+func BufferData[E any](target GLEnum, srcData []E, usage GLEnum) {
+	checkElementType("BufferData", srcData)
+	backend.BufferData(target, sliceSize(srcData), slicePointer(srcData), usage)
 }
 
-// BufferSubData updates a subset of a buffer object's data store.
-func BufferSubData(target GLEnum, offset int, srcData []float32) {
-	gl.BufferSubData(uint32(target), offset*4, len(srcData)*4, unsafe.Pointer(&srcData[0]))
+// BufferDataSize initializes and creates the buffer object's data store of
+// size bytes, leaving its contents undefined. Use BufferSubData to fill it
+// afterwards.
+func BufferDataSize(target GLEnum, size int, usage GLEnum) {
+	backend.BufferData(target, size, nil, usage)
+}
+
+// BufferSubData updates a subset of a buffer object's data store with srcData.
+// The offset is given in elements of srcData, not in bytes, and must be used
+// with a data store holding elements of the same type. Nothing is updated if
+// srcData is empty.
+//
+// The same element types as for BufferData are supported.
+func BufferSubData[E any](target GLEnum, offset int, srcData []E) {
+	checkElementType("BufferSubData", srcData)
+	if len(srcData) == 0 {
+		return
+	}
+	backend.BufferSubData(target, offset*elementSize(srcData), sliceSize(srcData), slicePointer(srcData))
+}
+
+// pointerFreeTypes caches the results of pointerFree by type, so that the
+// element types of slices are walked only once, on their first upload.
+var pointerFreeTypes sync.Map
+
+// checkElementType panics if the elements of the slice cannot be uploaded as
+// they are stored in memory, as they hold pointers, which would be meaningless
+// to OpenGL.
+func checkElementType[E any](function string, slice []E) {
+	t := reflect.TypeOf(slice).Elem()
+	free, ok := pointerFreeTypes.Load(t)
+	if !ok {
+		free = pointerFree(t)
+		pointerFreeTypes.Store(t, free)
+	}
+	if !free.(bool) {
+		panic(fmt.Sprintf("gogl: %s: element type %v contains pointers", function, t))
+	}
+}
+
+// pointerFree reports whether values of type t consist of booleans and numbers
+// only.
+func pointerFree(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	case reflect.Array:
+		return pointerFree(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !pointerFree(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// elementSize returns the size of an element of the slice in bytes.
+func elementSize[E any](slice []E) int {
+	var element E
+	return int(unsafe.Sizeof(element))
+}
+
+// sliceSize returns the size of the elements of the slice in bytes.
+func sliceSize[E any](slice []E) int {
+	return len(slice) * elementSize(slice)
+}
+
+// slicePointer returns a pointer to the first element of the slice, or nil if
+// the slice is empty.
+func slicePointer[E any](slice []E) unsafe.Pointer {
+	if len(slice) == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&slice[0])
 }
 
 // CreateBuffer creates and initializes a Buffer storing data such as vertices
 // or colors.
 func CreateBuffer() Buffer {
-	var buffer uint32
-	gl.GenBuffers(1, &buffer)
-	return Buffer(buffer)
+	return backend.CreateBuffer()
 }
 
 // Delete deletes the Buffer. This method has no effect if the buffer has
 // already been deleted.
 func (buffer Buffer) Delete() {
-	// TODO: Is it somehow possible to get &uint32(buffer) without assigning it to buffers?
-	buffers := uint32(buffer)
-	gl.DeleteBuffers(1, &buffers)
+	backend.DeleteBuffer(buffer)
 }
 
 // GetBufferSize returns an int32 indicating the size of the buffer in bytes.
 func GetBufferSize(target GLEnum) int32 {
-	var params int32
-	gl.GetBufferParameteriv(uint32(target), gl.BUFFER_SIZE, &params)
-	return params
+	return backend.GetBufferParameteri(target, gl.BUFFER_SIZE)
 }
 
 // GetBufferUsage returns a GLEnum indicating the usage pattern of the buffer.
 func GetBufferUsage(target GLEnum) GLEnum {
-	var params int32
-	gl.GetBufferParameteriv(uint32(target), gl.BUFFER_USAGE, &params)
-	return GLEnum(params)
+	return GLEnum(backend.GetBufferParameteri(target, gl.BUFFER_USAGE))
 }
 
 // IsBuffer returns true if the Buffer is valid and false otherwise.
 func (buffer Buffer) IsBuffer() bool {
-	return gl.IsBuffer(uint32(buffer))
+	return backend.IsBuffer(buffer)
 }
@@ -0,0 +1,90 @@
+package gogl_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+type vertex struct {
+	Position [3]float32
+	Color    [4]uint8
+}
+
+func TestBufferData(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+
+	gogl.BufferData(gogl.GLElementArrayBuffer, []uint16{1, 2, 0x0304}, gogl.GLStaticDraw)
+	gogl.BufferData(gogl.GLArrayBuffer, []vertex{{[3]float32{1, 0, -1}, [4]uint8{255, 128, 0, 255}}}, gogl.GLStaticDraw)
+	gogl.BufferData(gogl.GLArrayBuffer, []float32{}, gogl.GLDynamicDraw)
+
+	var position bytes.Buffer
+	binary.Write(&position, binary.LittleEndian, []float32{1, 0, -1})
+	tests := []struct {
+		want string
+		data []byte
+	}{
+		{"BufferData(GL_ELEMENT_ARRAY_BUFFER, 6, GL_STATIC_DRAW)", []byte{1, 0, 2, 0, 4, 3}},
+		{"BufferData(GL_ARRAY_BUFFER, 16, GL_STATIC_DRAW)", append(position.Bytes(), 255, 128, 0, 255)},
+		{"BufferData(GL_ARRAY_BUFFER, 0, GL_DYNAMIC_DRAW)", nil},
+	}
+	if len(b.Calls) != len(tests) {
+		t.Fatalf("calls = %v, want %d calls", b.Calls, len(tests))
+	}
+	for i, test := range tests {
+		call := b.Calls[i]
+		if got := call.String(); got != test.want {
+			t.Errorf("call %d = %s, want %s", i, got, test.want)
+		}
+		if !reflect.DeepEqual(call.Data, test.data) {
+			t.Errorf("%s data = %v, want %v", test.want, call.Data, test.data)
+		}
+	}
+}
+
+func TestBufferSubData(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+
+	gogl.BufferSubData(gogl.GLArrayBuffer, 2, []vertex{{}, {}})
+	gogl.BufferSubData(gogl.GLElementArrayBuffer, 3, []uint16{7})
+	gogl.BufferSubData(gogl.GLArrayBuffer, 5, []vertex{})
+
+	want := []string{
+		"BufferSubData(GL_ARRAY_BUFFER, 32, 32)",
+		"BufferSubData(GL_ELEMENT_ARRAY_BUFFER, 6, 2)",
+	}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
+
+func TestBufferDataPointers(t *testing.T) {
+	initBackend(t, "3.3.0", "")
+	type named struct {
+		Position [3]float32
+		Name     string
+	}
+	tests := []struct {
+		name   string
+		upload func()
+		want   string
+	}{
+		{"pointer", func() { gogl.BufferData(gogl.GLArrayBuffer, []*float32{nil}, gogl.GLStaticDraw) }, "gogl: BufferData: element type *float32 contains pointers"},
+		{"string field", func() { gogl.BufferData(gogl.GLArrayBuffer, []named{}, gogl.GLStaticDraw) }, "gogl: BufferData: element type gogl_test.named contains pointers"},
+		{"cached", func() { gogl.BufferSubData(gogl.GLArrayBuffer, 0, []named{{}}) }, "gogl: BufferSubData: element type gogl_test.named contains pointers"},
+		{"slice", func() { gogl.BufferSubData(gogl.GLArrayBuffer, 0, [][]float32{}) }, "gogl: BufferSubData: element type []float32 contains pointers"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("panic = %v, want %q", r, test.want)
+				}
+			}()
+			test.upload()
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package gogl
+
+import (
+	"strconv"
+	"strings"
+)
+
+// contextCapabilities is the version and the extensions of the OpenGL context,
+// and the features that depend on them.
+type contextCapabilities struct {
+	major, minor int
+	extensions   map[string]bool
+	instancing   bool
+	vertexArrays bool
+}
+
+// capabilities caches the capabilities of the context of the current Backend.
+// They are queried by InitWithBackend, or on first use if it has not been
+// called.
+var capabilities *contextCapabilities
+
+// queryCapabilities queries the capabilities of the context of the current
+// Backend.
+func queryCapabilities() *contextCapabilities {
+	c := &contextCapabilities{extensions: map[string]bool{}}
+	c.major, c.minor = parseVersion(GetString(GLVersion))
+	for _, extension := range strings.Fields(GetString(GLExtensions)) {
+		c.extensions[extension] = true
+	}
+	// Core profiles report no extensions through GetString(GLExtensions), so
+	// the version is checked first. Drivers export the entry points of the ARB
+	// extensions the Backend calls as aliases of the core functions, which
+	// were added by OpenGL 3.1 (draw instanced) and 3.3 (instanced arrays).
+	c.instancing = c.atLeast(3, 3) ||
+		(c.atLeast(3, 1) || c.extensions["GL_ARB_draw_instanced"]) && c.extensions["GL_ARB_instanced_arrays"]
+	c.vertexArrays = c.major >= 3 || c.extensions["GL_ARB_vertex_array_object"]
+	return c
+}
+
+// atLeast reports whether the version is at least major.minor.
+func (c *contextCapabilities) atLeast(major, minor int) bool {
+	return c.major > major || c.major == major && c.minor >= minor
+}
+
+// currentCapabilities returns the capabilities of the context, querying them
+// if InitWithBackend has not been called.
+func currentCapabilities() *contextCapabilities {
+	if capabilities == nil {
+		capabilities = queryCapabilities()
+	}
+	return capabilities
+}
+
+// Version returns the major and minor OpenGL version of the context, as
+// reported by GetString(GLVersion). Both are 0 if the version cannot be
+// parsed.
+func Version() (major, minor int) {
+	c := currentCapabilities()
+	return c.major, c.minor
+}
+
+// HasExtension reports whether the context supports the OpenGL extension,
+// e.g., "GL_ARB_vertex_array_object". The extensions are queried with
+// GetString(GLExtensions) by InitWithBackend.
+func HasExtension(extension string) bool {
+	return currentCapabilities().extensions[extension]
+}
+
+// InstancingSupported reports whether the context supports instanced drawing
+// with DrawArraysInstanced, DrawElementsInstanced and VertexAttribDivisor,
+// i.e., it is an OpenGL 3.3 or newer context, an OpenGL 3.1 or newer context
+// supporting GL_ARB_instanced_arrays, or supports both GL_ARB_draw_instanced
+// and GL_ARB_instanced_arrays. Support is checked by InitWithBackend.
+func InstancingSupported() bool {
+	return currentCapabilities().instancing
+}
+
+// SeamlessCubeMapSupported reports whether the context supports seamless
+// filtering across the faces of cube maps with GLTextureCubeMapSeamless, i.e.,
+// it is an OpenGL 3.2 or newer context, or supports GL_ARB_seamless_cube_map.
+func SeamlessCubeMapSupported() bool {
+	return versionAtLeast(3, 2) || HasExtension("GL_ARB_seamless_cube_map")
+}
+
+// versionAtLeast reports whether the OpenGL version of the context is at least
+// major.minor.
+func versionAtLeast(major, minor int) bool {
+	return currentCapabilities().atLeast(major, minor)
+}
+
+// parseVersion parses the version at the start of a GL_VERSION string, e.g.,
+// "4.6 (Compatibility Profile) Mesa 23.1.0" or "OpenGL ES 3.2 NVIDIA".
+func parseVersion(version string) (major, minor int) {
+	version = strings.TrimPrefix(version, "OpenGL ES ")
+	version, _, _ = strings.Cut(version, " ")
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return 0, 0
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0
+	}
+	return major, minor
+}
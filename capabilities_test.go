@@ -0,0 +1,91 @@
+package gogl_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		version      string
+		major, minor int
+	}{
+		{"4.6 (Compatibility Profile) Mesa 23.1.0", 4, 6},
+		{"3.3.0 NVIDIA 535.54", 3, 3},
+		{"2.1 Mesa 20.3.5", 2, 1},
+		{"4.1 ATI-4.14.1", 4, 1},
+		{"OpenGL ES 3.2 NVIDIA 535.54", 3, 2},
+		{"3", 0, 0},
+		{"", 0, 0},
+		{"unknown", 0, 0},
+	}
+	for _, test := range tests {
+		initBackend(t, test.version, "")
+		if major, minor := gogl.Version(); major != test.major || minor != test.minor {
+			t.Errorf("Version() for %q = %d.%d, want %d.%d", test.version, major, minor, test.major, test.minor)
+		}
+	}
+}
+
+func TestHasExtension(t *testing.T) {
+	initBackend(t, "2.1 Mesa 20.3.5", "GL_ARB_vertex_array_object  GL_EXT_texture_sRGB\n")
+	for extension, want := range map[string]bool{
+		"GL_ARB_vertex_array_object": true,
+		"GL_EXT_texture_sRGB":        true,
+		"GL_ARB_instanced_arrays":    false,
+		"":                           false,
+	} {
+		if got := gogl.HasExtension(extension); got != want {
+			t.Errorf("HasExtension(%q) = %t, want %t", extension, got, want)
+		}
+	}
+}
+
+func TestInstancingSupported(t *testing.T) {
+	tests := []struct {
+		name                string
+		version, extensions string
+		want                bool
+	}{
+		{"core 3.3", "3.3.0 NVIDIA 535.54", "", true},
+		{"core 4.6", "4.6 (Core Profile) Mesa 23.1.0", "", true},
+		{"3.1 with instanced arrays", "3.1 Mesa 20.3.5", "GL_ARB_instanced_arrays", true},
+		{"3.1", "3.1 Mesa 20.3.5", "", false},
+		{"3.2 with draw instanced", "3.2 Mesa 20.3.5", "GL_ARB_draw_instanced", false},
+		{"2.1 with both extensions", "2.1 Mesa 20.3.5", "GL_ARB_draw_instanced GL_ARB_instanced_arrays", true},
+		{"2.1 with instanced arrays", "2.1 Mesa 20.3.5", "GL_ARB_instanced_arrays", false},
+		{"2.1", "2.1 Mesa 20.3.5", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, test.version, test.extensions)
+			if got := gogl.InstancingSupported(); got != test.want {
+				t.Errorf("InstancingSupported() = %t, want %t", got, test.want)
+			}
+
+			ib := &gogl.IndexBuffer{Type: gogl.GLUInt16}
+			var want error
+			if !test.want {
+				want = gogl.ErrInstancingUnsupported
+			}
+			errs := []error{
+				gogl.DrawArraysInstanced(gogl.GLTriangles, 0, 3, 2),
+				gogl.DrawElementsInstanced(gogl.GLTriangles, 3, gogl.GLUInt16, 0, 2),
+				gogl.VertexAttribDivisor(1, 1),
+				ib.DrawInstanced(gogl.GLTriangles, 2),
+			}
+			for i, err := range errs {
+				if !errors.Is(err, want) {
+					t.Errorf("call %d error = %v, want %v", i, err, want)
+				}
+			}
+
+			calls := len(b.CallsTo("DrawArraysInstanced")) + len(b.CallsTo("DrawElementsInstanced")) + len(b.CallsTo("VertexAttribDivisor"))
+			if wantCalls := map[bool]int{true: 3, false: 0}[test.want]; calls != wantCalls {
+				t.Errorf("got %d instancing calls, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
@@ -237,6 +237,8 @@ const (
 	GLVendor                        GLEnum = gl.VENDOR
 	GLRenderer                      GLEnum = gl.RENDERER
 	GLVersion                       GLEnum = gl.VERSION
+	GLExtensions                    GLEnum = gl.EXTENSIONS
+	GLVertexArrayBinding            GLEnum = gl.VERTEX_ARRAY_BINDING
 	GLImplementationColorReadType   GLEnum = gl.IMPLEMENTATION_COLOR_READ_TYPE
 	GLImplementationColorReadFormat GLEnum = gl.IMPLEMENTATION_COLOR_READ_FORMAT
 )
@@ -377,7 +379,7 @@ const (
 	GLNicest GLEnum = gl.NICEST
 	// GLGenerateMipmapHint is a hint for the quality of filtering when
 	// generating mimap images with GenerateMipmap.
-	GLGenerateMipmapHint = gl.GENERATE_MIPMAP_HINT
+	GLGenerateMipmapHint GLEnum = gl.GENERATE_MIPMAP_HINT
 )
 
 // Data types
@@ -399,13 +401,44 @@ const (
 	GLRGBA           GLEnum = gl.RGBA
 	GLLuminance      GLEnum = gl.LUMINANCE
 	GLLuminanceAlpha GLEnum = gl.LUMINANCE_ALPHA
+	GLBGRA           GLEnum = gl.BGRA
+	GLRed            GLEnum = gl.RED
+	GLRG             GLEnum = gl.RG
 )
 
 // Pixel types
 const (
-	GLUInt164444 GLEnum = gl.UNSIGNED_SHORT_4_4_4_4
-	GLUInt165551 GLEnum = gl.UNSIGNED_SHORT_5_5_5_1
-	GLUInt16565  GLEnum = gl.UNSIGNED_SHORT_5_6_5
+	GLUInt164444        GLEnum = gl.UNSIGNED_SHORT_4_4_4_4
+	GLUInt165551        GLEnum = gl.UNSIGNED_SHORT_5_5_5_1
+	GLUInt16565         GLEnum = gl.UNSIGNED_SHORT_5_6_5
+	GLUInt8888Rev       GLEnum = gl.UNSIGNED_INT_8_8_8_8_REV
+	GLUInt248           GLEnum = gl.UNSIGNED_INT_24_8
+	GLFloat32UInt248Rev GLEnum = gl.FLOAT_32_UNSIGNED_INT_24_8_REV
+)
+
+// Sized internal formats
+//
+// Constants passed as the internal format to TexImage2D or
+// RenderbufferStorage.
+const (
+	GLR8                GLEnum = gl.R8
+	GLRG8               GLEnum = gl.RG8
+	GLRGB8              GLEnum = gl.RGB8
+	GLRGBA8             GLEnum = gl.RGBA8
+	GLRGB10A2           GLEnum = gl.RGB10_A2
+	GLR16F              GLEnum = gl.R16F
+	GLRG16F             GLEnum = gl.RG16F
+	GLRGB16F            GLEnum = 0x881B
+	GLRGBA16F           GLEnum = 0x881A
+	GLR32F              GLEnum = gl.R32F
+	GLRG32F             GLEnum = gl.RG32F
+	GLRGB32F            GLEnum = gl.RGB32F
+	GLRGBA32F           GLEnum = 0x8814
+	GLDepthComponent24  GLEnum = gl.DEPTH_COMPONENT24
+	GLDepth24Stencil8   GLEnum = gl.DEPTH24_STENCIL8
+	GLDepthComponent32  GLEnum = gl.DEPTH_COMPONENT32
+	GLDepthComponent32F GLEnum = gl.DEPTH_COMPONENT32F
+	GLDepth32FStencil8  GLEnum = gl.DEPTH32F_STENCIL8
 )
 
 // Shaders
@@ -520,6 +553,7 @@ const (
 	GLTextureMinFilter        GLEnum = gl.TEXTURE_MIN_FILTER
 	GLTextureWrapS            GLEnum = gl.TEXTURE_WRAP_S
 	GLTextureWrapT            GLEnum = gl.TEXTURE_WRAP_T
+	GLTextureWrapR            GLEnum = gl.TEXTURE_WRAP_R
 	GLTexture2D               GLEnum = gl.TEXTURE_2D
 	GLTexture                 GLEnum = gl.TEXTURE
 	GLTextureCubeMap          GLEnum = gl.TEXTURE_CUBE_MAP
@@ -531,6 +565,18 @@ const (
 	GLTextureCubeMapPositiveZ GLEnum = gl.TEXTURE_CUBE_MAP_POSITIVE_Z
 	GLTextureCubeMapNegativeZ GLEnum = gl.TEXTURE_CUBE_MAP_NEGATIVE_Z
 	GLMaxCubeMapTextureSize   GLEnum = gl.MAX_CUBE_MAP_TEXTURE_SIZE
+	GLTextureCubeMapSeamless  GLEnum = gl.TEXTURE_CUBE_MAP_SEAMLESS
+	GLTextureBaseLevel        GLEnum = gl.TEXTURE_BASE_LEVEL
+	GLTextureMaxLevel         GLEnum = gl.TEXTURE_MAX_LEVEL
+	// GLTextureCompareMode is GLNone, or GLCompareRefToTexture to compare the
+	// texture coordinate r of depth textures to their depth with the function
+	// set as GLTextureCompareFunc, e.g., for shadow maps.
+	GLTextureCompareMode  GLEnum = gl.TEXTURE_COMPARE_MODE
+	GLTextureCompareFunc  GLEnum = gl.TEXTURE_COMPARE_FUNC
+	GLCompareRefToTexture GLEnum = gl.COMPARE_R_TO_TEXTURE
+	GLSRGB8               GLEnum = gl.SRGB8
+	GLSRGB8Alpha8         GLEnum = gl.SRGB8_ALPHA8
+	GLSLuminance8         GLEnum = gl.SLUMINANCE8
 	// GLTexture0 is a texture unit.
 	GLTexture0 GLEnum = gl.TEXTURE0
 	// GLTexture1 is a texture unit.
@@ -602,6 +648,27 @@ const (
 	GLMirroredRepeat GLEnum = gl.MIRRORED_REPEAT
 )
 
+// Compressed texture formats
+//
+// Constants passed to CompressedTexImage2D and CompressedTexSubImage2D. Each
+// requires an extension, see TextureData.Upload.
+const (
+	GLCompressedRGBS3TCDXT1        GLEnum = gl.COMPRESSED_RGB_S3TC_DXT1_EXT
+	GLCompressedRGBAS3TCDXT1       GLEnum = gl.COMPRESSED_RGBA_S3TC_DXT1_EXT
+	GLCompressedRGBAS3TCDXT3       GLEnum = gl.COMPRESSED_RGBA_S3TC_DXT3_EXT
+	GLCompressedRGBAS3TCDXT5       GLEnum = gl.COMPRESSED_RGBA_S3TC_DXT5_EXT
+	GLCompressedSRGBS3TCDXT1       GLEnum = gl.COMPRESSED_SRGB_S3TC_DXT1_EXT
+	GLCompressedSRGBAlphaS3TCDXT1  GLEnum = gl.COMPRESSED_SRGB_ALPHA_S3TC_DXT1_EXT
+	GLCompressedSRGBAlphaS3TCDXT3  GLEnum = gl.COMPRESSED_SRGB_ALPHA_S3TC_DXT3_EXT
+	GLCompressedSRGBAlphaS3TCDXT5  GLEnum = gl.COMPRESSED_SRGB_ALPHA_S3TC_DXT5_EXT
+	GLCompressedRedRGTC1           GLEnum = gl.COMPRESSED_RED_RGTC1
+	GLCompressedSignedRedRGTC1     GLEnum = gl.COMPRESSED_SIGNED_RED_RGTC1
+	GLCompressedRGRGTC2            GLEnum = gl.COMPRESSED_RG_RGTC2
+	GLCompressedSignedRGRGTC2      GLEnum = gl.COMPRESSED_SIGNED_RG_RGTC2
+	GLCompressedRGBABPTCUnorm      GLEnum = 0x8E8C
+	GLCompressedSRGBAlphaBPTCUnorm GLEnum = 0x8E8D
+)
+
 // Uniform types
 const (
 	GLFloatVec2   GLEnum = gl.FLOAT_VEC2
@@ -655,6 +722,21 @@ const (
 	GLFramebufferAttachmentTextureLevel       GLEnum = gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL
 	GLFramebufferAttachmentTextureCubeMapFace GLEnum = gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE
 	GLColorAttachment0                        GLEnum = gl.COLOR_ATTACHMENT0
+	GLColorAttachment1                        GLEnum = gl.COLOR_ATTACHMENT1
+	GLColorAttachment2                        GLEnum = gl.COLOR_ATTACHMENT2
+	GLColorAttachment3                        GLEnum = gl.COLOR_ATTACHMENT3
+	GLColorAttachment4                        GLEnum = gl.COLOR_ATTACHMENT4
+	GLColorAttachment5                        GLEnum = gl.COLOR_ATTACHMENT5
+	GLColorAttachment6                        GLEnum = gl.COLOR_ATTACHMENT6
+	GLColorAttachment7                        GLEnum = gl.COLOR_ATTACHMENT7
+	GLColorAttachment8                        GLEnum = gl.COLOR_ATTACHMENT8
+	GLColorAttachment9                        GLEnum = gl.COLOR_ATTACHMENT9
+	GLColorAttachment10                       GLEnum = gl.COLOR_ATTACHMENT10
+	GLColorAttachment11                       GLEnum = gl.COLOR_ATTACHMENT11
+	GLColorAttachment12                       GLEnum = gl.COLOR_ATTACHMENT12
+	GLColorAttachment13                       GLEnum = gl.COLOR_ATTACHMENT13
+	GLColorAttachment14                       GLEnum = gl.COLOR_ATTACHMENT14
+	GLColorAttachment15                       GLEnum = gl.COLOR_ATTACHMENT15
 	GLDepthAttachment                         GLEnum = gl.DEPTH_ATTACHMENT
 	GLStencilAttachment                       GLEnum = gl.STENCIL_ATTACHMENT
 	GLDepthStencilAttachment                  GLEnum = gl.DEPTH_STENCIL_ATTACHMENT
@@ -663,8 +745,25 @@ const (
 	GLFramebufferIncompleteAttachment         GLEnum = gl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
 	GLFramebufferIncompleteMissingAttachment  GLEnum = gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT
 	GLFramebufferUnsupported                  GLEnum = gl.FRAMEBUFFER_UNSUPPORTED
+	GLFramebufferIncompleteDimensions         GLEnum = gl.FRAMEBUFFER_INCOMPLETE_DIMENSIONS_EXT
+	GLFramebufferIncompleteDrawBuffer         GLEnum = gl.FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER
+	GLFramebufferIncompleteReadBuffer         GLEnum = gl.FRAMEBUFFER_INCOMPLETE_READ_BUFFER
+	GLFramebufferIncompleteMultisample        GLEnum = gl.FRAMEBUFFER_INCOMPLETE_MULTISAMPLE
+	GLFramebufferUndefined                    GLEnum = gl.FRAMEBUFFER_UNDEFINED
+	GLFramebufferDefault                      GLEnum = gl.FRAMEBUFFER_DEFAULT
 	GLFramebufferBinding                      GLEnum = gl.FRAMEBUFFER_BINDING
-	GLRenderbufferBinding                     GLEnum = gl.RENDERBUFFER_BINDING
-	GLMaxRenderbufferSize                     GLEnum = gl.MAX_RENDERBUFFER_SIZE
-	GLInvalidFramebufferOperation             GLEnum = gl.INVALID_FRAMEBUFFER_OPERATION
+	GLReadFramebuffer                         GLEnum = gl.READ_FRAMEBUFFER
+	GLDrawFramebuffer                         GLEnum = gl.DRAW_FRAMEBUFFER
+	GLReadFramebufferBinding                  GLEnum = gl.READ_FRAMEBUFFER_BINDING
+	// GLDrawFramebufferBinding is the same parameter as GLFramebufferBinding.
+	GLDrawFramebufferBinding      GLEnum = gl.DRAW_FRAMEBUFFER_BINDING
+	GLRenderbufferSamples         GLEnum = gl.RENDERBUFFER_SAMPLES
+	GLMaxSamples                  GLEnum = gl.MAX_SAMPLES
+	GLRenderbufferBinding         GLEnum = gl.RENDERBUFFER_BINDING
+	GLMaxRenderbufferSize         GLEnum = gl.MAX_RENDERBUFFER_SIZE
+	GLMaxColorAttachments         GLEnum = gl.MAX_COLOR_ATTACHMENTS
+	GLMaxDrawBuffers              GLEnum = gl.MAX_DRAW_BUFFERS
+	GLDrawBuffer                  GLEnum = gl.DRAW_BUFFER
+	GLReadBuffer                  GLEnum = gl.READ_BUFFER
+	GLInvalidFramebufferOperation GLEnum = gl.INVALID_FRAMEBUFFER_OPERATION
 )
@@ -0,0 +1,388 @@
+package gogl
+
+import "fmt"
+
+// enumNames maps every constant of this package to its OpenGL name. Values
+// shared by several constants map to all of their names, separated by slashes.
+var enumNames = map[GLEnum]string{
+	GLDepthBufferBit:                    "GL_DEPTH_BUFFER_BIT",
+	GLStencilBufferBit:                  "GL_STENCIL_BUFFER_BIT",
+	GLColorBufferBit:                    "GL_COLOR_BUFFER_BIT",
+	GLPoints:                            "GL_POINTS/GL_ZERO/GL_NO_ERROR/GL_NONE",
+	GLLines:                             "GL_LINES/GL_ONE",
+	GLLineLoop:                          "GL_LINE_LOOP",
+	GLLineStrip:                         "GL_LINE_STRIP",
+	GLTriangles:                         "GL_TRIANGLES",
+	GLTriangleStrip:                     "GL_TRIANGLE_STRIP",
+	GLTriangleFan:                       "GL_TRIANGLE_FAN",
+	GLSrcColor:                          "GL_SRC_COLOR",
+	GLOneMinusSrcColor:                  "GL_ONE_MINUS_SRC_COLOR",
+	GLSrcAlpha:                          "GL_SRC_ALPHA",
+	GLOneMinusSrcAlpha:                  "GL_ONE_MINUS_SRC_ALPHA",
+	GLDstAlpha:                          "GL_DST_ALPHA",
+	GLOneMinusDstAlpha:                  "GL_ONE_MINUS_DST_ALPHA",
+	GLDstColor:                          "GL_DST_COLOR",
+	GLOneMinusDstColor:                  "GL_ONE_MINUS_DST_COLOR",
+	GLSrcAlphaSaturate:                  "GL_SRC_ALPHA_SATURATE",
+	GLConstantColor:                     "GL_CONSTANT_COLOR",
+	GLOneMinusConstantColor:             "GL_ONE_MINUS_CONSTANT_COLOR",
+	GLConstantAlpha:                     "GL_CONSTANT_ALPHA",
+	GLOneMinusConstantAlpha:             "GL_ONE_MINUS_CONSTANT_ALPHA",
+	GLFuncAdd:                           "GL_FUNC_ADD",
+	GLFuncSubtract:                      "GL_FUNC_SUBTRACT",
+	GLFuncReverseSubtract:               "GL_FUNC_REVERSE_SUBTRACT",
+	GLBlendEquation:                     "GL_BLEND_EQUATION/GL_BLEND_EQUATION_RGB",
+	GLBlendEquationAlpha:                "GL_BLEND_EQUATION_ALPHA",
+	GLBlendDstRGB:                       "GL_BLEND_DST_RGB",
+	GLBlendSrcRGB:                       "GL_BLEND_SRC_RGB",
+	GLBlendDstAlpha:                     "GL_BLEND_DST_ALPHA",
+	GLBlendSrcAlpha:                     "GL_BLEND_SRC_ALPHA",
+	GLBlendColor:                        "GL_BLEND_COLOR",
+	GLArrayBufferBinding:                "GL_ARRAY_BUFFER_BINDING",
+	GLElementArrayBufferBinding:         "GL_ELEMENT_ARRAY_BUFFER_BINDING",
+	GLLineWidth:                         "GL_LINE_WIDTH",
+	GLAliasedPointSizeRange:             "GL_ALIASED_POINT_SIZE_RANGE",
+	GLAliasedLineWidthRange:             "GL_ALIASED_LINE_WIDTH_RANGE",
+	GLCullFaceMode:                      "GL_CULL_FACE_MODE",
+	GLFrontFace:                         "GL_FRONT_FACE",
+	GLDepthRange:                        "GL_DEPTH_RANGE",
+	GLDepthWritemask:                    "GL_DEPTH_WRITEMASK",
+	GLDepthClearValue:                   "GL_DEPTH_CLEAR_VALUE",
+	GLDepthFunc:                         "GL_DEPTH_FUNC",
+	GLStencilClearValue:                 "GL_STENCIL_CLEAR_VALUE",
+	GLStencilFunc:                       "GL_STENCIL_FUNC",
+	GLStencilFail:                       "GL_STENCIL_FAIL",
+	GLStencilPassDepthFail:              "GL_STENCIL_PASS_DEPTH_FAIL",
+	GLStencilPassDepthPass:              "GL_STENCIL_PASS_DEPTH_PASS",
+	GLStencilRef:                        "GL_STENCIL_REF",
+	GLStencilValueMask:                  "GL_STENCIL_VALUE_MASK",
+	GLStencilWritemask:                  "GL_STENCIL_WRITEMASK",
+	GLStencilBackFunc:                   "GL_STENCIL_BACK_FUNC",
+	GLStencilBackFail:                   "GL_STENCIL_BACK_FAIL",
+	GLStencilBackPassDepthFail:          "GL_STENCIL_BACK_PASS_DEPTH_FAIL",
+	GLStencilBackPassDepthPass:          "GL_STENCIL_BACK_PASS_DEPTH_PASS",
+	GLStencilBackRef:                    "GL_STENCIL_BACK_REF",
+	GLStencilBackValueMask:              "GL_STENCIL_BACK_VALUE_MASK",
+	GLStencilBackWritemask:              "GL_STENCIL_BACK_WRITEMASK",
+	GLViewport:                          "GL_VIEWPORT",
+	GLScissorBox:                        "GL_SCISSOR_BOX",
+	GLColorClearValue:                   "GL_COLOR_CLEAR_VALUE",
+	GLColorWritemask:                    "GL_COLOR_WRITEMASK",
+	GLUnpackAlignment:                   "GL_UNPACK_ALIGNMENT",
+	GLPackAlignment:                     "GL_PACK_ALIGNMENT",
+	GLMaxTextureSize:                    "GL_MAX_TEXTURE_SIZE",
+	GLMaxViewportDims:                   "GL_MAX_VIEWPORT_DIMS",
+	GLSubpixelBits:                      "GL_SUBPIXEL_BITS",
+	GLRedBits:                           "GL_RED_BITS",
+	GLGreenBits:                         "GL_GREEN_BITS",
+	GLBlueBits:                          "GL_BLUE_BITS",
+	GLAlphaBits:                         "GL_ALPHA_BITS",
+	GLDepthBits:                         "GL_DEPTH_BITS",
+	GLStencilBits:                       "GL_STENCIL_BITS",
+	GLPolygonOffsetUnits:                "GL_POLYGON_OFFSET_UNITS",
+	GLPolygonOffsetFactor:               "GL_POLYGON_OFFSET_FACTOR",
+	GLTextureBinding2D:                  "GL_TEXTURE_BINDING_2D",
+	GLSampleBuffers:                     "GL_SAMPLE_BUFFERS",
+	GLSamples:                           "GL_SAMPLES",
+	GLSampleCoverageValue:               "GL_SAMPLE_COVERAGE_VALUE",
+	GLSampleCoverageInvert:              "GL_SAMPLE_COVERAGE_INVERT",
+	GLCompressedTextureFormats:          "GL_COMPRESSED_TEXTURE_FORMATS",
+	GLVendor:                            "GL_VENDOR",
+	GLRenderer:                          "GL_RENDERER",
+	GLVersion:                           "GL_VERSION",
+	GLExtensions:                        "GL_EXTENSIONS",
+	GLVertexArrayBinding:                "GL_VERTEX_ARRAY_BINDING",
+	GLImplementationColorReadType:       "GL_IMPLEMENTATION_COLOR_READ_TYPE",
+	GLImplementationColorReadFormat:     "GL_IMPLEMENTATION_COLOR_READ_FORMAT",
+	GLStaticDraw:                        "GL_STATIC_DRAW",
+	GLStreamDraw:                        "GL_STREAM_DRAW",
+	GLDynamicDraw:                       "GL_DYNAMIC_DRAW",
+	GLArrayBuffer:                       "GL_ARRAY_BUFFER",
+	GLElementArrayBuffer:                "GL_ELEMENT_ARRAY_BUFFER",
+	GLBufferSize:                        "GL_BUFFER_SIZE",
+	GLBufferUsage:                       "GL_BUFFER_USAGE",
+	GLCurrentVertexAttrib:               "GL_CURRENT_VERTEX_ATTRIB",
+	GLVertexAttribArrayEnabled:          "GL_VERTEX_ATTRIB_ARRAY_ENABLED",
+	GLVertexAttribArraySize:             "GL_VERTEX_ATTRIB_ARRAY_SIZE",
+	GLVertexAttribArrayStride:           "GL_VERTEX_ATTRIB_ARRAY_STRIDE",
+	GLVertexAttribArrayType:             "GL_VERTEX_ATTRIB_ARRAY_TYPE",
+	GLVertexAttribArrayNormalized:       "GL_VERTEX_ATTRIB_ARRAY_NORMALIZED",
+	GLVertexAttribArrayPointer:          "GL_VERTEX_ATTRIB_ARRAY_POINTER",
+	GLVertexAttribArrayBufferBinding:    "GL_VERTEX_ATTRIB_ARRAY_BUFFER_BINDING",
+	GLCullFace:                          "GL_CULL_FACE",
+	GLFront:                             "GL_FRONT",
+	GLBack:                              "GL_BACK",
+	GLFrontAndBack:                      "GL_FRONT_AND_BACK",
+	GLBlend:                             "GL_BLEND",
+	GLDepthTest:                         "GL_DEPTH_TEST",
+	GLDither:                            "GL_DITHER",
+	GLPolygonOffsetFill:                 "GL_POLYGON_OFFSET_FILL",
+	GLSampleAlphaToCoverage:             "GL_SAMPLE_ALPHA_TO_COVERAGE",
+	GLSampleCoverage:                    "GL_SAMPLE_COVERAGE",
+	GLScissorTest:                       "GL_SCISSOR_TEST",
+	GLStencilTest:                       "GL_STENCIL_TEST",
+	GLInvalidEnum:                       "GL_INVALID_ENUM",
+	GLInvalidValue:                      "GL_INVALID_VALUE",
+	GLInvalidOperation:                  "GL_INVALID_OPERATION",
+	GLOutOfMemory:                       "GL_OUT_OF_MEMORY",
+	GLContextLost:                       "GL_CONTEXT_LOST",
+	GLCW:                                "GL_CW",
+	GLCCW:                               "GL_CCW",
+	GLDontCare:                          "GL_DONT_CARE",
+	GLFastest:                           "GL_FASTEST",
+	GLNicest:                            "GL_NICEST",
+	GLGenerateMipmapHint:                "GL_GENERATE_MIPMAP_HINT",
+	GLInt8:                              "GL_BYTE",
+	GLUInt8:                             "GL_UNSIGNED_BYTE",
+	GLInt16:                             "GL_SHORT",
+	GLUInt16:                            "GL_UNSIGNED_SHORT",
+	GLInt32:                             "GL_INT",
+	GLUInt32:                            "GL_UNSIGNED_INT",
+	GLFloat32:                           "GL_FLOAT",
+	GLDepthComponent:                    "GL_DEPTH_COMPONENT",
+	GLAlpha:                             "GL_ALPHA",
+	GLRGB:                               "GL_RGB",
+	GLRGBA:                              "GL_RGBA",
+	GLLuminance:                         "GL_LUMINANCE",
+	GLLuminanceAlpha:                    "GL_LUMINANCE_ALPHA",
+	GLBGRA:                              "GL_BGRA",
+	GLUInt164444:                        "GL_UNSIGNED_SHORT_4_4_4_4",
+	GLUInt165551:                        "GL_UNSIGNED_SHORT_5_5_5_1",
+	GLUInt16565:                         "GL_UNSIGNED_SHORT_5_6_5",
+	GLUInt8888Rev:                       "GL_UNSIGNED_INT_8_8_8_8_REV",
+	GLRed:                               "GL_RED",
+	GLRG:                                "GL_RG",
+	GLUInt248:                           "GL_UNSIGNED_INT_24_8",
+	GLFloat32UInt248Rev:                 "GL_FLOAT_32_UNSIGNED_INT_24_8_REV",
+	GLR8:                                "GL_R8",
+	GLRG8:                               "GL_RG8",
+	GLRGB8:                              "GL_RGB8",
+	GLRGBA8:                             "GL_RGBA8",
+	GLRGB10A2:                           "GL_RGB10_A2",
+	GLR16F:                              "GL_R16F",
+	GLRG16F:                             "GL_RG16F",
+	GLRGB16F:                            "GL_RGB16F",
+	GLRGBA16F:                           "GL_RGBA16F",
+	GLR32F:                              "GL_R32F",
+	GLRG32F:                             "GL_RG32F",
+	GLRGB32F:                            "GL_RGB32F",
+	GLRGBA32F:                           "GL_RGBA32F",
+	GLDepthComponent24:                  "GL_DEPTH_COMPONENT24",
+	GLDepth24Stencil8:                   "GL_DEPTH24_STENCIL8",
+	GLDepthComponent32:                  "GL_DEPTH_COMPONENT32",
+	GLDepthComponent32F:                 "GL_DEPTH_COMPONENT32F",
+	GLDepth32FStencil8:                  "GL_DEPTH32F_STENCIL8",
+	GLFragmentShader:                    "GL_FRAGMENT_SHADER",
+	GLVertexShader:                      "GL_VERTEX_SHADER",
+	GLCompileStatus:                     "GL_COMPILE_STATUS",
+	GLDeleteStatus:                      "GL_DELETE_STATUS",
+	GLLinkStatus:                        "GL_LINK_STATUS",
+	GLValidateStatus:                    "GL_VALIDATE_STATUS",
+	GLAttachedShaders:                   "GL_ATTACHED_SHADERS",
+	GLActiveAttributes:                  "GL_ACTIVE_ATTRIBUTES",
+	GLActiveUniforms:                    "GL_ACTIVE_UNIFORMS",
+	GLMaxVertexAttribs:                  "GL_MAX_VERTEX_ATTRIBS",
+	GLMaxVertexUniformVectors:           "GL_MAX_VERTEX_UNIFORM_VECTORS",
+	GLMaxVaryingVectors:                 "GL_MAX_VARYING_VECTORS",
+	GLMaxCombinedTextureImageUnits:      "GL_MAX_COMBINED_TEXTURE_IMAGE_UNITS",
+	GLMaxVertexTextureImageUnits:        "GL_MAX_VERTEX_TEXTURE_IMAGE_UNITS",
+	GLMaxTextureImageUnits:              "GL_MAX_TEXTURE_IMAGE_UNITS",
+	GLMaxFragmentUniformVectors:         "GL_MAX_FRAGMENT_UNIFORM_VECTORS",
+	GLShaderType:                        "GL_SHADER_TYPE",
+	GLShadingLanguageVersion:            "GL_SHADING_LANGUAGE_VERSION",
+	GLCurrentProgram:                    "GL_CURRENT_PROGRAM",
+	GLNever:                             "GL_NEVER",
+	GLLess:                              "GL_LESS",
+	GLEqual:                             "GL_EQUAL",
+	GLLEqual:                            "GL_LEQUAL",
+	GLGreater:                           "GL_GREATER",
+	GLNotEqual:                          "GL_NOTEQUAL",
+	GLGEqual:                            "GL_GEQUAL",
+	GLAlways:                            "GL_ALWAYS",
+	GLKeep:                              "GL_KEEP",
+	GLReplace:                           "GL_REPLACE",
+	GLIncr:                              "GL_INCR",
+	GLDecr:                              "GL_DECR",
+	GLInvert:                            "GL_INVERT",
+	GLIncrWrap:                          "GL_INCR_WRAP",
+	GLDecrWrap:                          "GL_DECR_WRAP",
+	GLNearest:                           "GL_NEAREST",
+	GLLinear:                            "GL_LINEAR",
+	GLNearestMipmapNearest:              "GL_NEAREST_MIPMAP_NEAREST",
+	GLLinearMipmapNearest:               "GL_LINEAR_MIPMAP_NEAREST",
+	GLNearestMipmapLinear:               "GL_NEAREST_MIPMAP_LINEAR",
+	GLLinearMipmapLinear:                "GL_LINEAR_MIPMAP_LINEAR",
+	GLTextureMagFilter:                  "GL_TEXTURE_MAG_FILTER",
+	GLTextureMinFilter:                  "GL_TEXTURE_MIN_FILTER",
+	GLTextureWrapS:                      "GL_TEXTURE_WRAP_S",
+	GLTextureWrapT:                      "GL_TEXTURE_WRAP_T",
+	GLTextureWrapR:                      "GL_TEXTURE_WRAP_R",
+	GLTexture2D:                         "GL_TEXTURE_2D",
+	GLTexture:                           "GL_TEXTURE",
+	GLTextureCubeMap:                    "GL_TEXTURE_CUBE_MAP",
+	GLTextureBindingCubeMap:             "GL_TEXTURE_BINDING_CUBE_MAP",
+	GLTextureCubeMapPositiveX:           "GL_TEXTURE_CUBE_MAP_POSITIVE_X",
+	GLTextureCubeMapNegativeX:           "GL_TEXTURE_CUBE_MAP_NEGATIVE_X",
+	GLTextureCubeMapPositiveY:           "GL_TEXTURE_CUBE_MAP_POSITIVE_Y",
+	GLTextureCubeMapNegativeY:           "GL_TEXTURE_CUBE_MAP_NEGATIVE_Y",
+	GLTextureCubeMapPositiveZ:           "GL_TEXTURE_CUBE_MAP_POSITIVE_Z",
+	GLTextureCubeMapNegativeZ:           "GL_TEXTURE_CUBE_MAP_NEGATIVE_Z",
+	GLMaxCubeMapTextureSize:             "GL_MAX_CUBE_MAP_TEXTURE_SIZE",
+	GLTextureCubeMapSeamless:            "GL_TEXTURE_CUBE_MAP_SEAMLESS",
+	GLTextureBaseLevel:                  "GL_TEXTURE_BASE_LEVEL",
+	GLTextureMaxLevel:                   "GL_TEXTURE_MAX_LEVEL",
+	GLTextureCompareMode:                "GL_TEXTURE_COMPARE_MODE",
+	GLTextureCompareFunc:                "GL_TEXTURE_COMPARE_FUNC",
+	GLCompareRefToTexture:               "GL_COMPARE_REF_TO_TEXTURE",
+	GLSRGB8:                             "GL_SRGB8",
+	GLSRGB8Alpha8:                       "GL_SRGB8_ALPHA8",
+	GLSLuminance8:                       "GL_SLUMINANCE8",
+	GLCompressedRGBS3TCDXT1:             "GL_COMPRESSED_RGB_S3TC_DXT1_EXT",
+	GLCompressedRGBAS3TCDXT1:            "GL_COMPRESSED_RGBA_S3TC_DXT1_EXT",
+	GLCompressedRGBAS3TCDXT3:            "GL_COMPRESSED_RGBA_S3TC_DXT3_EXT",
+	GLCompressedRGBAS3TCDXT5:            "GL_COMPRESSED_RGBA_S3TC_DXT5_EXT",
+	GLCompressedSRGBS3TCDXT1:            "GL_COMPRESSED_SRGB_S3TC_DXT1_EXT",
+	GLCompressedSRGBAlphaS3TCDXT1:       "GL_COMPRESSED_SRGB_ALPHA_S3TC_DXT1_EXT",
+	GLCompressedSRGBAlphaS3TCDXT3:       "GL_COMPRESSED_SRGB_ALPHA_S3TC_DXT3_EXT",
+	GLCompressedSRGBAlphaS3TCDXT5:       "GL_COMPRESSED_SRGB_ALPHA_S3TC_DXT5_EXT",
+	GLCompressedRedRGTC1:                "GL_COMPRESSED_RED_RGTC1",
+	GLCompressedSignedRedRGTC1:          "GL_COMPRESSED_SIGNED_RED_RGTC1",
+	GLCompressedRGRGTC2:                 "GL_COMPRESSED_RG_RGTC2",
+	GLCompressedSignedRGRGTC2:           "GL_COMPRESSED_SIGNED_RG_RGTC2",
+	GLCompressedRGBABPTCUnorm:           "GL_COMPRESSED_RGBA_BPTC_UNORM",
+	GLCompressedSRGBAlphaBPTCUnorm:      "GL_COMPRESSED_SRGB_ALPHA_BPTC_UNORM",
+	GLTexture0:                          "GL_TEXTURE0",
+	GLTexture1:                          "GL_TEXTURE1",
+	GLTexture2:                          "GL_TEXTURE2",
+	GLTexture3:                          "GL_TEXTURE3",
+	GLTexture4:                          "GL_TEXTURE4",
+	GLTexture5:                          "GL_TEXTURE5",
+	GLTexture6:                          "GL_TEXTURE6",
+	GLTexture7:                          "GL_TEXTURE7",
+	GLTexture8:                          "GL_TEXTURE8",
+	GLTexture9:                          "GL_TEXTURE9",
+	GLTexture10:                         "GL_TEXTURE10",
+	GLTexture11:                         "GL_TEXTURE11",
+	GLTexture12:                         "GL_TEXTURE12",
+	GLTexture13:                         "GL_TEXTURE13",
+	GLTexture14:                         "GL_TEXTURE14",
+	GLTexture15:                         "GL_TEXTURE15",
+	GLTexture16:                         "GL_TEXTURE16",
+	GLTexture17:                         "GL_TEXTURE17",
+	GLTexture18:                         "GL_TEXTURE18",
+	GLTexture19:                         "GL_TEXTURE19",
+	GLTexture20:                         "GL_TEXTURE20",
+	GLTexture21:                         "GL_TEXTURE21",
+	GLTexture22:                         "GL_TEXTURE22",
+	GLTexture23:                         "GL_TEXTURE23",
+	GLTexture24:                         "GL_TEXTURE24",
+	GLTexture25:                         "GL_TEXTURE25",
+	GLTexture26:                         "GL_TEXTURE26",
+	GLTexture27:                         "GL_TEXTURE27",
+	GLTexture28:                         "GL_TEXTURE28",
+	GLTexture29:                         "GL_TEXTURE29",
+	GLTexture30:                         "GL_TEXTURE30",
+	GLTexture31:                         "GL_TEXTURE31",
+	GLActiveTexture:                     "GL_ACTIVE_TEXTURE",
+	GLRepeat:                            "GL_REPEAT",
+	GLClampToEdge:                       "GL_CLAMP_TO_EDGE",
+	GLMirroredRepeat:                    "GL_MIRRORED_REPEAT",
+	GLFloatVec2:                         "GL_FLOAT_VEC2",
+	GLFloatVec3:                         "GL_FLOAT_VEC3",
+	GLFloatVec4:                         "GL_FLOAT_VEC4",
+	GLIntVec2:                           "GL_INT_VEC2",
+	GLIntVec3:                           "GL_INT_VEC3",
+	GLIntVec4:                           "GL_INT_VEC4",
+	GLBool:                              "GL_BOOL",
+	GLBoolVec2:                          "GL_BOOL_VEC2",
+	GLBoolVec3:                          "GL_BOOL_VEC3",
+	GLBoolVec4:                          "GL_BOOL_VEC4",
+	GLFloatMat2:                         "GL_FLOAT_MAT2",
+	GLFloatMat3:                         "GL_FLOAT_MAT3",
+	GLFloatMat4:                         "GL_FLOAT_MAT4",
+	GLSampler2D:                         "GL_SAMPLER_2D",
+	GLSamplerCube:                       "GL_SAMPLER_CUBE",
+	GLLowFloat:                          "GL_LOW_FLOAT",
+	GLMediumFloat:                       "GL_MEDIUM_FLOAT",
+	GLHighFloat:                         "GL_HIGH_FLOAT",
+	GLLowInt:                            "GL_LOW_INT",
+	GLMediumInt:                         "GL_MEDIUM_INT",
+	GLHighInt:                           "GL_HIGH_INT",
+	GLFramebuffer:                       "GL_FRAMEBUFFER",
+	GLRenderbuffer:                      "GL_RENDERBUFFER",
+	GLRGBA4:                             "GL_RGBA4",
+	GLRGB5A1:                            "GL_RGB5_A1",
+	GLRGB565:                            "GL_RGB565",
+	GLDepthComponent16:                  "GL_DEPTH_COMPONENT16",
+	GLStencilIndex8:                     "GL_STENCIL_INDEX8",
+	GLDepthStencil:                      "GL_DEPTH_STENCIL",
+	GLRenderbufferWidth:                 "GL_RENDERBUFFER_WIDTH",
+	GLRenderbufferHeight:                "GL_RENDERBUFFER_HEIGHT",
+	GLRenderbufferInternalFormat:        "GL_RENDERBUFFER_INTERNAL_FORMAT",
+	GLRenderbufferRedSize:               "GL_RENDERBUFFER_RED_SIZE",
+	GLRenderbufferGreenSize:             "GL_RENDERBUFFER_GREEN_SIZE",
+	GLRenderbufferBlueSize:              "GL_RENDERBUFFER_BLUE_SIZE",
+	GLRenderbufferAlphaSize:             "GL_RENDERBUFFER_ALPHA_SIZE",
+	GLRenderbufferDepthSize:             "GL_RENDERBUFFER_DEPTH_SIZE",
+	GLRenderbufferStencilSize:           "GL_RENDERBUFFER_STENCIL_SIZE",
+	GLFramebufferAttachmentObjectType:   "GL_FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE",
+	GLFramebufferAttachmentObjectName:   "GL_FRAMEBUFFER_ATTACHMENT_OBJECT_NAME",
+	GLFramebufferAttachmentTextureLevel: "GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL",
+	GLFramebufferAttachmentTextureCubeMapFace: "GL_FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE",
+	GLColorAttachment0:                        "GL_COLOR_ATTACHMENT0",
+	GLColorAttachment1:                        "GL_COLOR_ATTACHMENT1",
+	GLColorAttachment2:                        "GL_COLOR_ATTACHMENT2",
+	GLColorAttachment3:                        "GL_COLOR_ATTACHMENT3",
+	GLColorAttachment4:                        "GL_COLOR_ATTACHMENT4",
+	GLColorAttachment5:                        "GL_COLOR_ATTACHMENT5",
+	GLColorAttachment6:                        "GL_COLOR_ATTACHMENT6",
+	GLColorAttachment7:                        "GL_COLOR_ATTACHMENT7",
+	GLColorAttachment8:                        "GL_COLOR_ATTACHMENT8",
+	GLColorAttachment9:                        "GL_COLOR_ATTACHMENT9",
+	GLColorAttachment10:                       "GL_COLOR_ATTACHMENT10",
+	GLColorAttachment11:                       "GL_COLOR_ATTACHMENT11",
+	GLColorAttachment12:                       "GL_COLOR_ATTACHMENT12",
+	GLColorAttachment13:                       "GL_COLOR_ATTACHMENT13",
+	GLColorAttachment14:                       "GL_COLOR_ATTACHMENT14",
+	GLColorAttachment15:                       "GL_COLOR_ATTACHMENT15",
+	GLDepthAttachment:                         "GL_DEPTH_ATTACHMENT",
+	GLStencilAttachment:                       "GL_STENCIL_ATTACHMENT",
+	GLDepthStencilAttachment:                  "GL_DEPTH_STENCIL_ATTACHMENT",
+	GLFramebufferComplete:                     "GL_FRAMEBUFFER_COMPLETE",
+	GLFramebufferIncompleteAttachment:         "GL_FRAMEBUFFER_INCOMPLETE_ATTACHMENT",
+	GLFramebufferIncompleteMissingAttachment:  "GL_FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT",
+	GLFramebufferUnsupported:                  "GL_FRAMEBUFFER_UNSUPPORTED",
+	GLFramebufferIncompleteDimensions:         "GL_FRAMEBUFFER_INCOMPLETE_DIMENSIONS",
+	GLFramebufferIncompleteDrawBuffer:         "GL_FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER",
+	GLFramebufferIncompleteReadBuffer:         "GL_FRAMEBUFFER_INCOMPLETE_READ_BUFFER",
+	GLFramebufferIncompleteMultisample:        "GL_FRAMEBUFFER_INCOMPLETE_MULTISAMPLE",
+	GLFramebufferUndefined:                    "GL_FRAMEBUFFER_UNDEFINED",
+	GLFramebufferDefault:                      "GL_FRAMEBUFFER_DEFAULT",
+	GLFramebufferBinding:                      "GL_FRAMEBUFFER_BINDING",
+	GLReadFramebuffer:                         "GL_READ_FRAMEBUFFER",
+	GLDrawFramebuffer:                         "GL_DRAW_FRAMEBUFFER",
+	GLReadFramebufferBinding:                  "GL_READ_FRAMEBUFFER_BINDING",
+	GLRenderbufferSamples:                     "GL_RENDERBUFFER_SAMPLES",
+	GLMaxSamples:                              "GL_MAX_SAMPLES",
+	GLRenderbufferBinding:                     "GL_RENDERBUFFER_BINDING",
+	GLMaxRenderbufferSize:                     "GL_MAX_RENDERBUFFER_SIZE",
+	GLMaxColorAttachments:                     "GL_MAX_COLOR_ATTACHMENTS",
+	GLMaxDrawBuffers:                          "GL_MAX_DRAW_BUFFERS",
+	GLDrawBuffer:                              "GL_DRAW_BUFFER",
+	GLReadBuffer:                              "GL_READ_BUFFER",
+	GLInvalidFramebufferOperation:             "GL_INVALID_FRAMEBUFFER_OPERATION",
+}
+
+// String returns the OpenGL name of the constant, e.g., "GL_INVALID_OPERATION"
+// for GLInvalidOperation.
+//
+// Values shared by several constants are printed as all of their names,
+// separated by slashes, e.g., "GL_POINTS/GL_ZERO/GL_NO_ERROR/GL_NONE" for 0.
+// Values not defined by this package are printed as hexadecimal numbers, e.g.,
+// "GLEnum(0x8CE1)".
+func (enum GLEnum) String() string {
+	if name, ok := enumNames[enum]; ok {
+		return name
+	}
+	return fmt.Sprintf("GLEnum(0x%04X)", uint32(enum))
+}
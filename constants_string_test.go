@@ -0,0 +1,24 @@
+package gogl
+
+import "testing"
+
+func TestGLEnumString(t *testing.T) {
+	tests := []struct {
+		enum GLEnum
+		want string
+	}{
+		{GLTexture2D, "GL_TEXTURE_2D"},
+		{GLRGBA, "GL_RGBA"},
+		{GLInvalidEnum, "GL_INVALID_ENUM"},
+		{GLColorAttachment0, "GL_COLOR_ATTACHMENT0"},
+		{GLNone, "GL_POINTS/GL_ZERO/GL_NO_ERROR/GL_NONE"},
+		{GLLines, "GL_LINES/GL_ONE"},
+		{GLEnum(0x1234), "GLEnum(0x1234)"},
+		{GLEnum(0xDEADBEEF), "GLEnum(0xDEADBEEF)"},
+	}
+	for _, test := range tests {
+		if got := test.enum.String(); got != test.want {
+			t.Errorf("GLEnum(0x%04X).String() = %q, want %q", uint32(test.enum), got, test.want)
+		}
+	}
+}
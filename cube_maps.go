@@ -0,0 +1,232 @@
+package gogl
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+)
+
+// NewCubeMap loads six square images of the same size into the faces of a new
+// cube map Texture, which is left bound to GLTextureCubeMap. The faces are
+// ordered like the constants GLTextureCubeMapPositiveX to
+// GLTextureCubeMapNegativeZ.
+//
+// Each face is uploaded with its top row first, as the faces of cube maps are
+// oriented as seen from the inside of the cube, with the top of the side faces
+// pointing to +Y, and the top of the +Y and -Y faces pointing to -Z and +Z
+// respectively. This is the layout most skyboxes are distributed in.
+//
+// The faces are converted like NewTextureFromImage converts images, except
+// that all of them are uploaded in the same format: GLLuminance if all of them
+// are *image.Gray, and GLRGBA otherwise. Unlike with the other loaders,
+// options.WrapS and options.WrapT, and GLTextureWrapR, default to
+// GLClampToEdge.
+func NewCubeMap(faces [6]image.Image, options TextureOptions) (Texture, error) {
+	size := faces[0].Bounds().Size()
+	if size.X != size.Y {
+		return 0, fmt.Errorf("gogl: cube map faces must be square, not %dx%d", size.X, size.Y)
+	}
+	for i, face := range faces {
+		if face.Bounds().Size() != size {
+			return 0, fmt.Errorf("gogl: cube map face %d is %v, not %v like face 0", i, face.Bounds().Size(), size)
+		}
+	}
+
+	// All faces of a cube map must share a format, so gray faces are only
+	// uploaded as GLLuminance if every face is gray.
+	gray := true
+	for _, face := range faces {
+		if _, ok := face.(*image.Gray); !ok {
+			gray = false
+		}
+	}
+
+	texture := CreateTexture()
+	BindTexture(GLTextureCubeMap, texture)
+	for i, face := range faces {
+		if _, ok := face.(*image.Gray); ok && !gray {
+			face = toNRGBA(face)
+		}
+		pixels, format, width, height := imageRows("NewCubeMap", convertAlpha(face, options.PremultiplyAlpha), false)
+		internalformat := format
+		if options.SRGB {
+			internalformat = GLSRGB8Alpha8
+			if format == GLLuminance {
+				internalformat = GLSLuminance8
+			}
+		}
+		backend.TexImage2D(GLTextureCubeMapPositiveX+GLEnum(i), 0, internalformat, width, height, 0, format, GLUInt8, slicePointer(pixels))
+	}
+	if options.Mipmaps {
+		GenerateMipmap(GLTextureCubeMap)
+	}
+	TexParameteri(GLTextureCubeMap, GLTextureMinFilter, int32(options.minFilter()))
+	TexParameteri(GLTextureCubeMap, GLTextureMagFilter, int32(orDefault(options.MagFilter, GLLinear)))
+	TexParameteri(GLTextureCubeMap, GLTextureWrapS, int32(orDefault(options.WrapS, GLClampToEdge)))
+	TexParameteri(GLTextureCubeMap, GLTextureWrapT, int32(orDefault(options.WrapT, GLClampToEdge)))
+	TexParameteri(GLTextureCubeMap, GLTextureWrapR, int32(GLClampToEdge))
+	if options.SeamlessCubeMap && SeamlessCubeMapSupported() {
+		Enable(GLTextureCubeMapSeamless)
+	}
+	return texture, nil
+}
+
+// NewCubeMapFromCross is like NewCubeMap, but cuts the faces out of a single
+// image in a cross layout. A horizontal cross is 4 faces wide and 3 faces high:
+//
+//	     +Y
+//	-X   +Z   +X   -Z
+//	     -Y
+//
+// A vertical cross is 3 faces wide and 4 faces high, with the -Z face below
+// the -Y face, upside down:
+//
+//	     +Y
+//	-X   +Z   +X
+//	     -Y
+//	     -Z
+func NewCubeMapFromCross(img image.Image, options TextureOptions) (Texture, error) {
+	bounds := img.Bounds()
+	var positions [6]image.Point
+	var size int
+	switch {
+	case bounds.Dx()*3 == bounds.Dy()*4:
+		size = bounds.Dx() / 4
+		positions = [6]image.Point{{2, 1}, {0, 1}, {1, 0}, {1, 2}, {1, 1}, {3, 1}}
+	case bounds.Dx()*4 == bounds.Dy()*3:
+		size = bounds.Dx() / 3
+		positions = [6]image.Point{{2, 1}, {0, 1}, {1, 0}, {1, 2}, {1, 1}, {1, 3}}
+	default:
+		return 0, fmt.Errorf("gogl: %dx%d is not the size of a cube map cross", bounds.Dx(), bounds.Dy())
+	}
+	if size == 0 {
+		return 0, errors.New("gogl: cube map cross is empty")
+	}
+
+	var faces [6]image.Image
+	for i, position := range positions {
+		min := bounds.Min.Add(position.Mul(size))
+		faces[i] = subImage(img, image.Rectangle{Min: min, Max: min.Add(image.Pt(size, size))})
+	}
+	if bounds.Dy() > bounds.Dx() {
+		faces[5] = rotate180(faces[5])
+	}
+	return NewCubeMap(faces, options)
+}
+
+// NewCubeMapFromEquirectangular is like NewCubeMap, but projects an
+// equirectangular panorama, which is twice as wide as it is high, onto faces of
+// size by size pixels. The center of the panorama faces -Z, and its top
+// faces +Y. The faces are sampled with bilinear filtering on the CPU.
+func NewCubeMapFromEquirectangular(img image.Image, size int, options TextureOptions) (Texture, error) {
+	bounds := img.Bounds()
+	if bounds.Empty() || size <= 0 {
+		return 0, errors.New("gogl: equirectangular cube map is empty")
+	}
+	source := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(source, source.Bounds(), img, bounds.Min, draw.Src)
+
+	var faces [6]image.Image
+	for i := range faces {
+		face := image.NewRGBA(image.Rect(0, 0, size, size))
+		for y := 0; y < size; y++ {
+			t := 2*(float64(y)+0.5)/float64(size) - 1
+			for x := 0; x < size; x++ {
+				s := 2*(float64(x)+0.5)/float64(size) - 1
+				dx, dy, dz := cubeMapDirection(i, s, t)
+				longitude := math.Atan2(dx, -dz)
+				latitude := math.Acos(dy / math.Sqrt(dx*dx+dy*dy+dz*dz))
+				u := (0.5 + longitude/(2*math.Pi)) * float64(source.Rect.Dx())
+				v := latitude / math.Pi * float64(source.Rect.Dy())
+				copy(face.Pix[face.PixOffset(x, y):], sampleBilinear(source, u, v))
+			}
+		}
+		faces[i] = face
+	}
+	return NewCubeMap(faces, options)
+}
+
+// cubeMapDirection returns the direction from the center of the cube to the
+// texture coordinates s and t, both in [-1, 1] with t pointing down, of the
+// face with the index i.
+func cubeMapDirection(i int, s, t float64) (x, y, z float64) {
+	switch GLTextureCubeMapPositiveX + GLEnum(i) {
+	case GLTextureCubeMapPositiveX:
+		return 1, -t, -s
+	case GLTextureCubeMapNegativeX:
+		return -1, -t, s
+	case GLTextureCubeMapPositiveY:
+		return s, 1, t
+	case GLTextureCubeMapNegativeY:
+		return s, -1, -t
+	case GLTextureCubeMapPositiveZ:
+		return s, -t, 1
+	default:
+		return -s, -t, -1
+	}
+}
+
+// sampleBilinear samples img at the pixel coordinates u and v, which wrap
+// around horizontally and are clamped vertically.
+func sampleBilinear(img *image.RGBA, u, v float64) []byte {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	u, v = u-0.5, v-0.5
+	x0, y0 := int(math.Floor(u)), int(math.Floor(v))
+	fx, fy := u-float64(x0), v-float64(y0)
+	wrap := func(x int) int { return (x%width + width) % width }
+	clamp := func(y int) int {
+		if y < 0 {
+			return 0
+		}
+		if y >= height {
+			return height - 1
+		}
+		return y
+	}
+	p00 := img.Pix[img.PixOffset(wrap(x0), clamp(y0)):]
+	p10 := img.Pix[img.PixOffset(wrap(x0+1), clamp(y0)):]
+	p01 := img.Pix[img.PixOffset(wrap(x0), clamp(y0+1)):]
+	p11 := img.Pix[img.PixOffset(wrap(x0+1), clamp(y0+1)):]
+	var pixel [4]byte
+	for c := range pixel {
+		top := float64(p00[c])*(1-fx) + float64(p10[c])*fx
+		bottom := float64(p01[c])*(1-fx) + float64(p11[c])*fx
+		pixel[c] = byte(math.Round(top*(1-fy) + bottom*fy))
+	}
+	return pixel[:]
+}
+
+// subImage returns the part r of img, sharing its pixels if img supports
+// SubImage, and copying them otherwise.
+func subImage(img image.Image, r image.Rectangle) image.Image {
+	if img, ok := img.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return img.SubImage(r)
+	}
+	copied := image.NewNRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(copied, copied.Bounds(), img, r.Min, draw.Src)
+	return copied
+}
+
+// toNRGBA returns a copy of img as an *image.NRGBA.
+func toNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	converted := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(converted, converted.Bounds(), img, bounds.Min, draw.Src)
+	return converted
+}
+
+// rotate180 returns a copy of img rotated by 180 degrees.
+func rotate180(img image.Image) image.Image {
+	bounds := img.Bounds()
+	rotated := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			rotated.Set(bounds.Dx()-1-x, bounds.Dy()-1-y, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return rotated
+}
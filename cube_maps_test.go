@@ -0,0 +1,258 @@
+package gogl_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"reflect"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/gogltest"
+)
+
+// faceColors are the colors of the faces of the test cube maps, in the order
+// of GLTextureCubeMapPositiveX to GLTextureCubeMapNegativeZ.
+var faceColors = [6]color.NRGBA{
+	{255, 0, 0, 255},
+	{0, 255, 0, 255},
+	{0, 0, 255, 255},
+	{255, 255, 0, 255},
+	{0, 255, 255, 255},
+	{255, 0, 255, 255},
+}
+
+// uniform returns a size by size image filled with c.
+func uniform(size int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(c), image.Point{}, draw.Src)
+	return img
+}
+
+// cubeMapFaces returns the pixels uploaded to the faces of the cube map, in the
+// order of GLTextureCubeMapPositiveX to GLTextureCubeMapNegativeZ.
+func cubeMapFaces(t *testing.T, b *gogltest.Backend) [6][]byte {
+	t.Helper()
+	var faces [6][]byte
+	calls := b.CallsTo("TexImage2D")
+	if len(calls) != 6 {
+		t.Fatalf("TexImage2D called %d times, want 6", len(calls))
+	}
+	for i, call := range calls {
+		if target := call.Args[0]; target != gogl.GLTextureCubeMapPositiveX+gogl.GLEnum(i) {
+			t.Errorf("TexImage2D call %d target = %v, want %v", i, target, gogl.GLTextureCubeMapPositiveX+gogl.GLEnum(i))
+		}
+		faces[i] = call.Data
+	}
+	return faces
+}
+
+func TestNewCubeMap(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	var faces [6]image.Image
+	for i := range faces {
+		faces[i] = uniform(2, faceColors[i])
+	}
+	texture, err := gogl.NewCubeMap(faces, gogl.TextureOptions{SeamlessCubeMap: true})
+	if err != nil {
+		t.Fatalf("NewCubeMap() error = %v", err)
+	}
+
+	want := []string{
+		"CreateTexture()",
+		fmt.Sprintf("BindTexture(GL_TEXTURE_CUBE_MAP, %d)", texture),
+	}
+	for i := 0; i < 6; i++ {
+		want = append(want, fmt.Sprintf("TexImage2D(%v, 0, GL_RGBA, 2, 2, 0, GL_RGBA, GL_UNSIGNED_BYTE)", gogl.GLTextureCubeMapPositiveX+gogl.GLEnum(i)))
+	}
+	want = append(want,
+		fmt.Sprintf("TexParameteri(GL_TEXTURE_CUBE_MAP, GL_TEXTURE_MIN_FILTER, %d)", gogl.GLLinear),
+		fmt.Sprintf("TexParameteri(GL_TEXTURE_CUBE_MAP, GL_TEXTURE_MAG_FILTER, %d)", gogl.GLLinear),
+		fmt.Sprintf("TexParameteri(GL_TEXTURE_CUBE_MAP, GL_TEXTURE_WRAP_S, %d)", gogl.GLClampToEdge),
+		fmt.Sprintf("TexParameteri(GL_TEXTURE_CUBE_MAP, GL_TEXTURE_WRAP_T, %d)", gogl.GLClampToEdge),
+		fmt.Sprintf("TexParameteri(GL_TEXTURE_CUBE_MAP, GL_TEXTURE_WRAP_R, %d)", gogl.GLClampToEdge),
+		"Enable(GL_TEXTURE_CUBE_MAP_SEAMLESS)",
+	)
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
+
+func TestNewCubeMapFormats(t *testing.T) {
+	gray := image.NewGray(image.Rect(0, 0, 1, 1))
+	gray.Pix[0] = 9
+	grayFaces := [6]image.Image{gray, gray, gray, gray, gray, gray}
+	mixedFaces := grayFaces
+	mixedFaces[3] = uniform(1, faceColors[3])
+
+	tests := []struct {
+		name   string
+		faces  [6]image.Image
+		format string
+		gray   []byte
+	}{
+		{"gray", grayFaces, "GL_LUMINANCE", []byte{9}},
+		{"mixed", mixedFaces, "GL_RGBA", []byte{9, 9, 9, 255}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			if _, err := gogl.NewCubeMap(test.faces, gogl.TextureOptions{}); err != nil {
+				t.Fatalf("NewCubeMap() error = %v", err)
+			}
+			for i, call := range b.CallsTo("TexImage2D") {
+				if format := call.Args[2].(gogl.GLEnum).String(); format != test.format {
+					t.Errorf("face %d format = %s, want %s", i, format, test.format)
+				}
+				if i != 3 && !reflect.DeepEqual(call.Data, test.gray) {
+					t.Errorf("face %d pixels = %v, want %v", i, call.Data, test.gray)
+				}
+			}
+		})
+	}
+}
+
+func TestNewCubeMapInvalid(t *testing.T) {
+	square := uniform(2, faceColors[0])
+	tests := []struct {
+		name  string
+		faces [6]image.Image
+		err   string
+	}{
+		{
+			"not square",
+			[6]image.Image{image.NewNRGBA(image.Rect(0, 0, 2, 1)), square, square, square, square, square},
+			"gogl: cube map faces must be square, not 2x1",
+		},
+		{
+			"different sizes",
+			[6]image.Image{square, square, uniform(4, faceColors[0]), square, square, square},
+			"gogl: cube map face 2 is (4,4), not (2,2) like face 0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			if _, err := gogl.NewCubeMap(test.faces, gogl.TextureOptions{}); err == nil || err.Error() != test.err {
+				t.Errorf("NewCubeMap() error = %v, want %q", err, test.err)
+			}
+			if len(b.Calls) != 0 {
+				t.Errorf("calls = %v, want none", b.Calls)
+			}
+		})
+	}
+}
+
+func TestNewCubeMapFromCross(t *testing.T) {
+	tests := []struct {
+		name      string
+		width     int
+		height    int
+		positions [6]image.Point
+	}{
+		{"horizontal", 8, 6, [6]image.Point{{2, 1}, {0, 1}, {1, 0}, {1, 2}, {1, 1}, {3, 1}}},
+		{"vertical", 6, 8, [6]image.Point{{2, 1}, {0, 1}, {1, 0}, {1, 2}, {1, 1}, {1, 3}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			cross := image.NewNRGBA(image.Rect(10, 20, 10+test.width, 20+test.height))
+			for i, position := range test.positions {
+				min := cross.Rect.Min.Add(position.Mul(2))
+				draw.Draw(cross, image.Rectangle{Min: min, Max: min.Add(image.Pt(2, 2))}, image.NewUniform(faceColors[i]), image.Point{}, draw.Src)
+			}
+			// Mark the top left pixel of -Z, which ends up at the bottom right
+			// of the face if it is upside down in a vertical cross.
+			cross.SetNRGBA(cross.Rect.Min.X+test.positions[5].X*2, cross.Rect.Min.Y+test.positions[5].Y*2, color.NRGBA{1, 2, 3, 255})
+
+			if _, err := gogl.NewCubeMapFromCross(cross, gogl.TextureOptions{}); err != nil {
+				t.Fatalf("NewCubeMapFromCross() error = %v", err)
+			}
+			for i, pixels := range cubeMapFaces(t, b) {
+				c := faceColors[i]
+				want := []byte{c.R, c.G, c.B, c.A, c.R, c.G, c.B, c.A, c.R, c.G, c.B, c.A, c.R, c.G, c.B, c.A}
+				if i == 5 {
+					marked := 0
+					if test.height > test.width {
+						marked = 12
+					}
+					copy(want[marked:], []byte{1, 2, 3, 255})
+				}
+				if !reflect.DeepEqual(pixels, want) {
+					t.Errorf("face %d = %v, want %v", i, pixels, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewCubeMapFromCrossInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		img  image.Image
+		err  string
+	}{
+		{"size", image.NewNRGBA(image.Rect(0, 0, 8, 8)), "gogl: 8x8 is not the size of a cube map cross"},
+		{"empty", image.NewNRGBA(image.Rect(0, 0, 0, 0)), "gogl: cube map cross is empty"},
+	}
+	for _, test := range tests {
+		initBackend(t, "3.3.0", "")
+		if _, err := gogl.NewCubeMapFromCross(test.img, gogl.TextureOptions{}); err == nil || err.Error() != test.err {
+			t.Errorf("NewCubeMapFromCross() with %s error = %v, want %q", test.name, err, test.err)
+		}
+	}
+}
+
+func TestNewCubeMapFromEquirectangular(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	// The top half of the panorama is the sky, and the bottom half is the
+	// ground. The half of the panorama in the middle faces -Z, and the
+	// quarters at the left and right edges face +Z.
+	sky, ground := color.NRGBA{0, 0, 255, 255}, color.NRGBA{0, 255, 0, 255}
+	front, back := color.NRGBA{255, 0, 0, 255}, color.NRGBA{255, 255, 0, 255}
+	panorama := image.NewNRGBA(image.Rect(0, 0, 64, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 64; x++ {
+			c := sky
+			switch {
+			case y >= 12 && y < 20 && x >= 16 && x < 48:
+				c = front
+			case y >= 12 && y < 20:
+				c = back
+			case y >= 16:
+				c = ground
+			}
+			panorama.SetNRGBA(x, y, c)
+		}
+	}
+
+	if _, err := gogl.NewCubeMapFromEquirectangular(panorama, 4, gogl.TextureOptions{}); err != nil {
+		t.Fatalf("NewCubeMapFromEquirectangular() error = %v", err)
+	}
+	faces := cubeMapFaces(t, b)
+	centers := map[gogl.GLEnum]color.NRGBA{
+		gogl.GLTextureCubeMapPositiveY: sky,
+		gogl.GLTextureCubeMapNegativeY: ground,
+		gogl.GLTextureCubeMapPositiveZ: back,
+		gogl.GLTextureCubeMapNegativeZ: front,
+	}
+	for target, c := range centers {
+		pixels := faces[target-gogl.GLTextureCubeMapPositiveX]
+		if len(pixels) != 4*4*4 {
+			t.Fatalf("%v has %d bytes, want %d", target, len(pixels), 4*4*4)
+		}
+		// The pixels around the center of the face sample the panorama
+		// without blending with the neighbouring areas.
+		for _, offset := range []int{(1*4 + 1) * 4, (2*4 + 2) * 4} {
+			if got, want := pixels[offset:offset+4], []byte{c.R, c.G, c.B, c.A}; !reflect.DeepEqual(got, want) {
+				t.Errorf("%v pixel at byte %d = %v, want %v", target, offset, got, want)
+			}
+		}
+	}
+
+	initBackend(t, "3.3.0", "")
+	if _, err := gogl.NewCubeMapFromEquirectangular(panorama, 0, gogl.TextureOptions{}); err == nil {
+		t.Error("NewCubeMapFromEquirectangular() with size 0 error = nil, want an error")
+	}
+}
@@ -0,0 +1,158 @@
+package gogl
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The flags and offsets of the DDS header, which follows the magic number
+// "DDS ".
+const (
+	ddsHeaderSize      = 4 + 124
+	ddsDX10HeaderSize  = 20
+	ddsFlagMipMapCount = 0x20000
+	ddsPixelFourCC     = 0x4
+	ddsCaps2CubeMap    = 0x200
+	ddsMiscCubeMap     = 0x4
+)
+
+// ParseDDS parses a DirectDraw Surface file with all of its mipmap levels and
+// cube map faces. Upload uploads the parsed texture.
+//
+// The supported formats are DXT1, DXT3 and DXT5 (BC1, BC2 and BC3), BC4 and
+// BC5, and BC7, which is only supported with a DX10 header. Texture arrays and
+// volume textures are not supported, and neither are textures larger than
+// 16384 in either dimension.
+func ParseDDS(data []byte) (*TextureData, error) {
+	if len(data) < ddsHeaderSize || string(data[:4]) != "DDS " {
+		return nil, errors.New("gogl: parsing DDS: not a DDS file")
+	}
+	le := binary.LittleEndian
+	flags := le.Uint32(data[8:])
+	height, width := int(le.Uint32(data[12:])), int(le.Uint32(data[16:]))
+	levels := 1
+	if flags&ddsFlagMipMapCount != 0 && le.Uint32(data[28:]) > 1 {
+		levels = int(le.Uint32(data[28:]))
+	}
+	pixelFlags, fourCC := le.Uint32(data[80:]), string(data[84:88])
+	if pixelFlags&ddsPixelFourCC == 0 {
+		return nil, errors.New("gogl: parsing DDS: uncompressed DDS files are not supported")
+	}
+	if err := checkTextureDataSize(width, height, levels); err != nil {
+		return nil, fmt.Errorf("gogl: parsing DDS: %w", err)
+	}
+	faces := 1
+	if le.Uint32(data[112:])&ddsCaps2CubeMap != 0 {
+		faces = 6
+	}
+
+	offset := ddsHeaderSize
+	var format GLEnum
+	if fourCC == "DX10" {
+		if len(data) < ddsHeaderSize+ddsDX10HeaderSize {
+			return nil, errors.New("gogl: parsing DDS: truncated DX10 header")
+		}
+		dxgiFormat := le.Uint32(data[offset:])
+		format = ddsDXGIFormat(dxgiFormat)
+		if format == 0 {
+			return nil, fmt.Errorf("gogl: parsing DDS: unsupported DXGI format %d", dxgiFormat)
+		}
+		if le.Uint32(data[offset+8:])&ddsMiscCubeMap != 0 {
+			faces = 6
+		}
+		if arraySize := le.Uint32(data[offset+12:]); arraySize > 1 {
+			return nil, errors.New("gogl: parsing DDS: texture arrays are not supported")
+		}
+		offset += ddsDX10HeaderSize
+	} else {
+		format = ddsFourCCFormat(fourCC)
+		if format == 0 {
+			return nil, fmt.Errorf("gogl: parsing DDS: unsupported format %q", fourCC)
+		}
+	}
+
+	texture := &TextureData{
+		Target:         GLTexture2D,
+		InternalFormat: format,
+		Width:          width,
+		Height:         height,
+		Alignment:      1,
+	}
+	if faces == 6 {
+		texture.Target = GLTextureCubeMap
+	}
+	// The images are stored face by face, with all levels of a face.
+	for face := 0; face < faces; face++ {
+		for level := 0; level < levels; level++ {
+			w, h := mipmapSize(width, level), mipmapSize(height, level)
+			size := compressedImageSize(format, w, h)
+			if size < 0 || size > len(data)-offset {
+				return nil, errors.New("gogl: parsing DDS: truncated image data")
+			}
+			texture.Images = append(texture.Images, TextureImage{
+				Level:  level,
+				Face:   face,
+				Width:  w,
+				Height: h,
+				Data:   data[offset : offset+size],
+			})
+			offset += size
+		}
+	}
+	return texture, nil
+}
+
+// ddsFourCCFormat returns the compressed format of a DDS FourCC code, or 0 if
+// it is not supported.
+func ddsFourCCFormat(fourCC string) GLEnum {
+	switch fourCC {
+	case "DXT1":
+		return GLCompressedRGBAS3TCDXT1
+	case "DXT2", "DXT3":
+		return GLCompressedRGBAS3TCDXT3
+	case "DXT4", "DXT5":
+		return GLCompressedRGBAS3TCDXT5
+	case "ATI1", "BC4U":
+		return GLCompressedRedRGTC1
+	case "BC4S":
+		return GLCompressedSignedRedRGTC1
+	case "ATI2", "BC5U":
+		return GLCompressedRGRGTC2
+	case "BC5S":
+		return GLCompressedSignedRGRGTC2
+	}
+	return 0
+}
+
+// ddsDXGIFormat returns the compressed format of a DXGI_FORMAT of a DX10
+// header, or 0 if it is not supported.
+func ddsDXGIFormat(format uint32) GLEnum {
+	switch format {
+	case 71: // DXGI_FORMAT_BC1_UNORM
+		return GLCompressedRGBAS3TCDXT1
+	case 72: // DXGI_FORMAT_BC1_UNORM_SRGB
+		return GLCompressedSRGBAlphaS3TCDXT1
+	case 74: // DXGI_FORMAT_BC2_UNORM
+		return GLCompressedRGBAS3TCDXT3
+	case 75: // DXGI_FORMAT_BC2_UNORM_SRGB
+		return GLCompressedSRGBAlphaS3TCDXT3
+	case 77: // DXGI_FORMAT_BC3_UNORM
+		return GLCompressedRGBAS3TCDXT5
+	case 78: // DXGI_FORMAT_BC3_UNORM_SRGB
+		return GLCompressedSRGBAlphaS3TCDXT5
+	case 80: // DXGI_FORMAT_BC4_UNORM
+		return GLCompressedRedRGTC1
+	case 81: // DXGI_FORMAT_BC4_SNORM
+		return GLCompressedSignedRedRGTC1
+	case 83: // DXGI_FORMAT_BC5_UNORM
+		return GLCompressedRGRGTC2
+	case 84: // DXGI_FORMAT_BC5_SNORM
+		return GLCompressedSignedRGRGTC2
+	case 98: // DXGI_FORMAT_BC7_UNORM
+		return GLCompressedRGBABPTCUnorm
+	case 99: // DXGI_FORMAT_BC7_UNORM_SRGB
+		return GLCompressedSRGBAlphaBPTCUnorm
+	}
+	return 0
+}
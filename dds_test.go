@@ -0,0 +1,121 @@
+package gogl
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// ddsFile returns a DDS file of the given dimensions, mipmap levels and
+// FourCC with size bytes of image data.
+func ddsFile(width, height, levels uint32, fourCC string, size int) []byte {
+	data := make([]byte, ddsHeaderSize+size)
+	le := binary.LittleEndian
+	copy(data, "DDS ")
+	le.PutUint32(data[4:], 124)
+	le.PutUint32(data[8:], ddsFlagMipMapCount)
+	le.PutUint32(data[12:], height)
+	le.PutUint32(data[16:], width)
+	le.PutUint32(data[28:], levels)
+	le.PutUint32(data[80:], ddsPixelFourCC)
+	copy(data[84:88], fourCC)
+	return data
+}
+
+func TestParseDDSInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  string
+	}{
+		{"empty", nil, "not a DDS file"},
+		{"truncated header", ddsFile(4, 4, 1, "DXT1", 0)[:100], "not a DDS file"},
+		{"truncated image", ddsFile(8, 8, 1, "DXT5", 63), "truncated image data"},
+		{"truncated mipmap", ddsFile(8, 8, 4, "DXT1", 32+8+8), "truncated image data"},
+		{"overflowing size", ddsFile(0xFFFFFFFF, 1<<31, 1, "DXT5", 16), "invalid size"},
+		{"too large", ddsFile(16385, 4, 1, "DXT1", 16), "invalid size"},
+		{"zero width", ddsFile(0, 4, 1, "DXT1", 16), "invalid size"},
+		{"too many levels", ddsFile(8, 8, 5, "DXT1", 1024), "mipmap levels exceed the maximum of 4"},
+		{"overflowing levels", ddsFile(4, 4, 0xFFFFFFFF, "DXT1", 8), "mipmap levels exceed"},
+		{"unsupported format", ddsFile(4, 4, 1, "ETC1", 8), "unsupported format"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			texture, err := ParseDDS(test.data)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("ParseDDS() = %v, %v, want error containing %q", texture, err, test.err)
+			}
+		})
+	}
+}
+
+func TestDecompressDXTInvalidSize(t *testing.T) {
+	if pixels := decompressDXT(GLCompressedRGBAS3TCDXT1, 1<<20, 1<<20, nil); pixels != nil {
+		t.Errorf("decompressDXT() returned %d bytes for a 1048576x1048576 image, want nil", len(pixels))
+	}
+}
+
+func TestParseDDS(t *testing.T) {
+	data := ddsFile(8, 4, 4, "DXT5", 32+16+16+16)
+	for i := ddsHeaderSize; i < len(data); i++ {
+		data[i] = byte(i)
+	}
+	texture, err := ParseDDS(data)
+	if err != nil {
+		t.Fatalf("ParseDDS() error = %v", err)
+	}
+	if texture.Target != GLTexture2D || texture.InternalFormat != GLCompressedRGBAS3TCDXT5 || texture.Width != 8 || texture.Height != 4 || !texture.Compressed() {
+		t.Errorf("ParseDDS() = %+v, want a compressed 8x4 GL_TEXTURE_2D of GL_COMPRESSED_RGBA_S3TC_DXT5_EXT", texture)
+	}
+	if got := texture.Levels(); got != 4 {
+		t.Errorf("Levels() = %d, want 4", got)
+	}
+	want := []struct{ width, height, offset, size int }{
+		{8, 4, 0, 32},
+		{4, 2, 32, 16},
+		{2, 1, 48, 16},
+		{1, 1, 64, 16},
+	}
+	for i, image := range texture.Images {
+		w := want[i]
+		if image.Level != i || image.Face != 0 || image.Width != w.width || image.Height != w.height || len(image.Data) != w.size || image.Data[0] != byte(ddsHeaderSize+w.offset) {
+			t.Errorf("Images[%d] = level %d, face %d, %dx%d, %d bytes, want level %d, face 0, %dx%d, %d bytes at offset %d",
+				i, image.Level, image.Face, image.Width, image.Height, len(image.Data), i, w.width, w.height, w.size, w.offset)
+		}
+	}
+}
+
+func TestParseDDSCubeMap(t *testing.T) {
+	data := ddsFile(4, 4, 2, "DXT1", 6*(8+8))
+	binary.LittleEndian.PutUint32(data[112:], ddsCaps2CubeMap)
+	texture, err := ParseDDS(data)
+	if err != nil {
+		t.Fatalf("ParseDDS() error = %v", err)
+	}
+	if texture.Target != GLTextureCubeMap || len(texture.Images) != 12 {
+		t.Fatalf("ParseDDS() = %v with %d images, want a GL_TEXTURE_CUBE_MAP with 12 images", texture.Target, len(texture.Images))
+	}
+	// The images are stored face by face.
+	for i, image := range texture.Images {
+		if image.Face != i/2 || image.Level != i%2 {
+			t.Errorf("Images[%d] = face %d, level %d, want face %d, level %d", i, image.Face, image.Level, i/2, i%2)
+		}
+	}
+}
+
+func TestParseDDSDX10(t *testing.T) {
+	data := ddsFile(4, 4, 1, "DX10", ddsDX10HeaderSize+16)
+	binary.LittleEndian.PutUint32(data[ddsHeaderSize:], 99)
+	texture, err := ParseDDS(data)
+	if err != nil {
+		t.Fatalf("ParseDDS() error = %v", err)
+	}
+	if texture.InternalFormat != GLCompressedSRGBAlphaBPTCUnorm || len(texture.Images) != 1 || len(texture.Images[0].Data) != 16 {
+		t.Errorf("ParseDDS() = %v with %d images, want GL_COMPRESSED_SRGB_ALPHA_BPTC_UNORM with 1 image of 16 bytes", texture.InternalFormat, len(texture.Images))
+	}
+
+	binary.LittleEndian.PutUint32(data[ddsHeaderSize+12:], 2)
+	if _, err := ParseDDS(data); err == nil || !strings.Contains(err.Error(), "texture arrays") {
+		t.Errorf("ParseDDS() of a texture array error = %v, want texture arrays are not supported", err)
+	}
+}
@@ -0,0 +1,904 @@
+package gogl
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// CallError is reported in debug mode when an OpenGL error is raised by a
+// call.
+type CallError struct {
+	// Caller is the name of the function of this package that was called
+	// from outside of it and made the failing call, e.g., "GetMaxSamples" or
+	// "(*RenderTarget).Resolve". It is empty if the Backend was called
+	// directly.
+	Caller string
+	// Function is the name of the Backend method that raised the error, which
+	// matches the name of the OpenGL function being called, e.g.,
+	// "GetIntegerv" for GetMaxSamples.
+	Function string
+	// Args are the arguments of the call. Pointers to payloads are left out.
+	Args []interface{}
+	// Code is the error returned by GetError after the call.
+	Code GLEnum
+}
+
+// Error returns the error formatted as
+// "gogl: BindTexture(GL_TEXTURE_2D, 7): GL_INVALID_OPERATION", prefixed with
+// the Caller if it differs from the Function, e.g.,
+// "gogl: GetMaxSamples: GetIntegerv(GL_MAX_SAMPLES): GL_INVALID_ENUM".
+func (err *CallError) Error() string {
+	args := make([]string, len(err.Args))
+	for i, arg := range err.Args {
+		args[i] = fmt.Sprint(arg)
+	}
+	call := fmt.Sprintf("%s(%s): %v", err.Function, strings.Join(args, ", "), Error(err.Code))
+	if err.Caller != "" && err.Caller != err.Function {
+		return fmt.Sprintf("gogl: %s: %s", err.Caller, call)
+	}
+	return "gogl: " + call
+}
+
+// Unwrap returns the Error of the call, so that errors.Is can match it with
+// the errors of this package, e.g., ErrInvalidEnum.
+func (err *CallError) Unwrap() error {
+	return Error(err.Code)
+}
+
+// LogCallError is a handler for EnableDebug that logs the error with the
+// standard logger. It is used if no handler is passed.
+func LogCallError(err *CallError) {
+	log.Print(err)
+}
+
+// PanicOnError is a handler for EnableDebug that panics with the *CallError of
+// the first failing call. It helps to pin down the first bad call in
+// development builds.
+func PanicOnError(err *CallError) {
+	panic(err)
+}
+
+// EnableDebug enables the debug mode, in which GetError is checked after every
+// call to the Backend. Every error is passed to handler, e.g., PanicOnError or
+// LogCallError, which is used if handler is nil.
+//
+// EnableDebug wraps the current Backend as NewDebugBackend does, so it must be
+// called after Init or InitWithBackend. Calling EnableDebug again replaces the
+// handler.
+//
+// The errors passed to handler are still returned by GetError and CheckError
+// afterwards, like in OpenGL, every distinct error once.
+//
+// Checking for errors after every call forces the driver to synchronize and is
+// slow, so the debug mode should not be enabled in release builds.
+func EnableDebug(handler func(err *CallError)) {
+	if debug, ok := backend.(*debugBackend); ok {
+		debug.handler = orLogCallError(handler)
+		return
+	}
+	backend = NewDebugBackend(backend, handler)
+}
+
+// DisableDebug disables the debug mode enabled by EnableDebug.
+func DisableDebug() {
+	if debug, ok := backend.(*debugBackend); ok {
+		backend = debug.Backend
+	}
+}
+
+// NewDebugBackend returns a Backend forwarding every call to b, and checking
+// GetError afterwards. Every error is passed to handler, or to LogCallError
+// if handler is nil.
+//
+// Use it with InitWithBackend to run in debug mode from the start, e.g., for
+// checking the calls made by rendering code under test.
+func NewDebugBackend(b Backend, handler func(err *CallError)) Backend {
+	return &debugBackend{Backend: b, handler: orLogCallError(handler)}
+}
+
+// orLogCallError returns handler, or LogCallError if handler is nil.
+func orLogCallError(handler func(err *CallError)) func(err *CallError) {
+	if handler == nil {
+		return LogCallError
+	}
+	return handler
+}
+
+// debugBackend is the Backend returned by NewDebugBackend. Init is forwarded
+// without checking for errors.
+type debugBackend struct {
+	Backend
+	handler func(err *CallError)
+	// errors are the distinct errors consumed by check, which are returned by
+	// GetError before those of the Backend.
+	errors []GLEnum
+}
+
+// check reports every error raised by the call of function with args. A lost
+// context is reported once, as it is not cleared by GetError.
+func (b *debugBackend) check(function string, args ...interface{}) {
+	for code := b.Backend.GetError(); code != GLNoError; code = b.Backend.GetError() {
+		b.handler(&CallError{Caller: caller(), Function: function, Args: args, Code: code})
+		if code == GLContextLost {
+			return
+		}
+		if !containsEnum(b.errors, code) {
+			b.errors = append(b.errors, code)
+		}
+	}
+}
+
+// GetError implements Backend. It returns the errors consumed by check first,
+// so that they are not lost to callers of GetError and CheckError.
+func (b *debugBackend) GetError() GLEnum {
+	if len(b.errors) > 0 {
+		code := b.errors[0]
+		b.errors = b.errors[1:]
+		return code
+	}
+	return b.Backend.GetError()
+}
+
+// containsEnum reports whether enums contains enum.
+func containsEnum(enums []GLEnum, enum GLEnum) bool {
+	for _, e := range enums {
+		if e == enum {
+			return true
+		}
+	}
+	return false
+}
+
+// packagePrefix is the prefix of the names of the functions of this package in
+// stack traces.
+var packagePrefix = reflect.TypeOf(CallError{}).PkgPath() + "."
+
+// caller returns the name of the outermost function of this package on the
+// stack of the calling goroutine, i.e., the one called from outside of it, or
+// "" if there is none.
+func caller() string {
+	pcs := make([]uintptr, 32)
+	// Skip runtime.Callers, caller and check.
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	name := ""
+	for more := true; more; {
+		var frame runtime.Frame
+		frame, more = frames.Next()
+		function := strings.TrimPrefix(frame.Function, packagePrefix)
+		if function == frame.Function {
+			break
+		}
+		if !strings.HasPrefix(function, "(*debugBackend).") {
+			// Generic functions are named like "BufferData[...]".
+			name = strings.TrimSuffix(function, "[...]")
+		}
+	}
+	return name
+}
+
+func (b *debugBackend) GetString(name GLEnum) string {
+	r := b.Backend.GetString(name)
+	b.check("GetString", name)
+	return r
+}
+
+func (b *debugBackend) BindBuffer(target GLEnum, buffer Buffer) {
+	b.Backend.BindBuffer(target, buffer)
+	b.check("BindBuffer", target, buffer)
+}
+
+func (b *debugBackend) BufferData(target GLEnum, size int, data unsafe.Pointer, usage GLEnum) {
+	b.Backend.BufferData(target, size, data, usage)
+	b.check("BufferData", target, size, usage)
+}
+
+func (b *debugBackend) BufferSubData(target GLEnum, offset, size int, data unsafe.Pointer) {
+	b.Backend.BufferSubData(target, offset, size, data)
+	b.check("BufferSubData", target, offset, size)
+}
+
+func (b *debugBackend) CreateBuffer() Buffer {
+	r := b.Backend.CreateBuffer()
+	b.check("CreateBuffer")
+	return r
+}
+
+func (b *debugBackend) DeleteBuffer(buffer Buffer) {
+	b.Backend.DeleteBuffer(buffer)
+	b.check("DeleteBuffer", buffer)
+}
+
+func (b *debugBackend) GetBufferParameteri(target, pname GLEnum) int32 {
+	r := b.Backend.GetBufferParameteri(target, pname)
+	b.check("GetBufferParameteri", target, pname)
+	return r
+}
+
+func (b *debugBackend) IsBuffer(buffer Buffer) bool {
+	r := b.Backend.IsBuffer(buffer)
+	b.check("IsBuffer", buffer)
+	return r
+}
+
+func (b *debugBackend) ActiveTexture(texture GLEnum) {
+	b.Backend.ActiveTexture(texture)
+	b.check("ActiveTexture", texture)
+}
+
+func (b *debugBackend) BlendColor(red, green, blue, alpha float32) {
+	b.Backend.BlendColor(red, green, blue, alpha)
+	b.check("BlendColor", red, green, blue, alpha)
+}
+
+func (b *debugBackend) BlendEquation(mode GLEnum) {
+	b.Backend.BlendEquation(mode)
+	b.check("BlendEquation", mode)
+}
+
+func (b *debugBackend) BlendEquationSeparate(modeRGB, modeAlpha GLEnum) {
+	b.Backend.BlendEquationSeparate(modeRGB, modeAlpha)
+	b.check("BlendEquationSeparate", modeRGB, modeAlpha)
+}
+
+func (b *debugBackend) BlendFunc(sfactor, dfactor GLEnum) {
+	b.Backend.BlendFunc(sfactor, dfactor)
+	b.check("BlendFunc", sfactor, dfactor)
+}
+
+func (b *debugBackend) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLEnum) {
+	b.Backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	b.check("BlendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (b *debugBackend) ClearColor(red, green, blue, alpha float32) {
+	b.Backend.ClearColor(red, green, blue, alpha)
+	b.check("ClearColor", red, green, blue, alpha)
+}
+
+func (b *debugBackend) ClearDepth(depth float32) {
+	b.Backend.ClearDepth(depth)
+	b.check("ClearDepth", depth)
+}
+
+func (b *debugBackend) ClearStencil(s int32) {
+	b.Backend.ClearStencil(s)
+	b.check("ClearStencil", s)
+}
+
+func (b *debugBackend) ColorMask(red, green, blue, alpha bool) {
+	b.Backend.ColorMask(red, green, blue, alpha)
+	b.check("ColorMask", red, green, blue, alpha)
+}
+
+func (b *debugBackend) CullFace(mode GLEnum) {
+	b.Backend.CullFace(mode)
+	b.check("CullFace", mode)
+}
+
+func (b *debugBackend) DepthFunc(xfunc GLEnum) {
+	b.Backend.DepthFunc(xfunc)
+	b.check("DepthFunc", xfunc)
+}
+
+func (b *debugBackend) DepthMask(flag bool) {
+	b.Backend.DepthMask(flag)
+	b.check("DepthMask", flag)
+}
+
+func (b *debugBackend) DepthRange(zNear, zFar float32) {
+	b.Backend.DepthRange(zNear, zFar)
+	b.check("DepthRange", zNear, zFar)
+}
+
+func (b *debugBackend) Disable(cap GLEnum) {
+	b.Backend.Disable(cap)
+	b.check("Disable", cap)
+}
+
+func (b *debugBackend) Enable(cap GLEnum) {
+	b.Backend.Enable(cap)
+	b.check("Enable", cap)
+}
+
+func (b *debugBackend) FrontFace(mode GLEnum) {
+	b.Backend.FrontFace(mode)
+	b.check("FrontFace", mode)
+}
+
+func (b *debugBackend) GetBooleanv(pname GLEnum, data []bool) {
+	b.Backend.GetBooleanv(pname, data)
+	b.check("GetBooleanv", pname, data)
+}
+
+func (b *debugBackend) GetFloatv(pname GLEnum, data []float32) {
+	b.Backend.GetFloatv(pname, data)
+	b.check("GetFloatv", pname, data)
+}
+
+func (b *debugBackend) GetIntegerv(pname GLEnum, data []int32) {
+	b.Backend.GetIntegerv(pname, data)
+	b.check("GetIntegerv", pname, data)
+}
+
+func (b *debugBackend) Hint(target, mode GLEnum) {
+	b.Backend.Hint(target, mode)
+	b.check("Hint", target, mode)
+}
+
+func (b *debugBackend) IsEnabled(cap GLEnum) bool {
+	r := b.Backend.IsEnabled(cap)
+	b.check("IsEnabled", cap)
+	return r
+}
+
+func (b *debugBackend) LineWidth(width float32) {
+	b.Backend.LineWidth(width)
+	b.check("LineWidth", width)
+}
+
+func (b *debugBackend) PixelStorei(pname GLEnum, param int32) {
+	b.Backend.PixelStorei(pname, param)
+	b.check("PixelStorei", pname, param)
+}
+
+func (b *debugBackend) PolygonOffset(factor, units float32) {
+	b.Backend.PolygonOffset(factor, units)
+	b.check("PolygonOffset", factor, units)
+}
+
+func (b *debugBackend) SampleCoverage(value float32, invert bool) {
+	b.Backend.SampleCoverage(value, invert)
+	b.check("SampleCoverage", value, invert)
+}
+
+func (b *debugBackend) StencilFunc(xfunc GLEnum, ref int32, mask uint32) {
+	b.Backend.StencilFunc(xfunc, ref, mask)
+	b.check("StencilFunc", xfunc, ref, mask)
+}
+
+func (b *debugBackend) StencilFuncSeparate(face, xfunc GLEnum, ref int32, mask uint32) {
+	b.Backend.StencilFuncSeparate(face, xfunc, ref, mask)
+	b.check("StencilFuncSeparate", face, xfunc, ref, mask)
+}
+
+func (b *debugBackend) StencilMask(mask uint32) {
+	b.Backend.StencilMask(mask)
+	b.check("StencilMask", mask)
+}
+
+func (b *debugBackend) StencilMaskSeparate(face GLEnum, mask uint32) {
+	b.Backend.StencilMaskSeparate(face, mask)
+	b.check("StencilMaskSeparate", face, mask)
+}
+
+func (b *debugBackend) StencilOp(fail, zfail, zpass GLEnum) {
+	b.Backend.StencilOp(fail, zfail, zpass)
+	b.check("StencilOp", fail, zfail, zpass)
+}
+
+func (b *debugBackend) StencilOpSeparate(face, fail, zfail, zpass GLEnum) {
+	b.Backend.StencilOpSeparate(face, fail, zfail, zpass)
+	b.check("StencilOpSeparate", face, fail, zfail, zpass)
+}
+
+func (b *debugBackend) Clear(mask GLEnum) {
+	b.Backend.Clear(mask)
+	b.check("Clear", mask)
+}
+
+func (b *debugBackend) DrawArrays(mode GLEnum, first, count int32) {
+	b.Backend.DrawArrays(mode, first, count)
+	b.check("DrawArrays", mode, first, count)
+}
+
+func (b *debugBackend) DrawArraysInstanced(mode GLEnum, first, count, instanceCount int32) {
+	b.Backend.DrawArraysInstanced(mode, first, count, instanceCount)
+	b.check("DrawArraysInstanced", mode, first, count, instanceCount)
+}
+
+func (b *debugBackend) DrawElements(mode GLEnum, count int32, xtype GLEnum, offset int) {
+	b.Backend.DrawElements(mode, count, xtype, offset)
+	b.check("DrawElements", mode, count, xtype, offset)
+}
+
+func (b *debugBackend) DrawElementsInstanced(mode GLEnum, count int32, xtype GLEnum, offset int, instanceCount int32) {
+	b.Backend.DrawElementsInstanced(mode, count, xtype, offset, instanceCount)
+	b.check("DrawElementsInstanced", mode, count, xtype, offset, instanceCount)
+}
+
+func (b *debugBackend) Finish() {
+	b.Backend.Finish()
+	b.check("Finish")
+}
+
+func (b *debugBackend) Flush() {
+	b.Backend.Flush()
+	b.check("Flush")
+}
+
+func (b *debugBackend) BindFramebuffer(target GLEnum, framebuffer Framebuffer) {
+	b.Backend.BindFramebuffer(target, framebuffer)
+	b.check("BindFramebuffer", target, framebuffer)
+}
+
+func (b *debugBackend) BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int32, mask, filter GLEnum) {
+	b.Backend.BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+	b.check("BlitFramebuffer", srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+}
+
+func (b *debugBackend) CheckFramebufferStatus(target GLEnum) GLEnum {
+	r := b.Backend.CheckFramebufferStatus(target)
+	b.check("CheckFramebufferStatus", target)
+	return r
+}
+
+func (b *debugBackend) CreateFramebuffer() Framebuffer {
+	r := b.Backend.CreateFramebuffer()
+	b.check("CreateFramebuffer")
+	return r
+}
+
+func (b *debugBackend) DeleteFramebuffer(framebuffer Framebuffer) {
+	b.Backend.DeleteFramebuffer(framebuffer)
+	b.check("DeleteFramebuffer", framebuffer)
+}
+
+func (b *debugBackend) DrawBuffer(mode GLEnum) {
+	b.Backend.DrawBuffer(mode)
+	b.check("DrawBuffer", mode)
+}
+
+func (b *debugBackend) DrawBuffers(buffers []GLEnum) {
+	b.Backend.DrawBuffers(buffers)
+	b.check("DrawBuffers", buffers)
+}
+
+func (b *debugBackend) FramebufferRenderbuffer(target, attachment, renderbuffertarget GLEnum, renderbuffer Renderbuffer) {
+	b.Backend.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+	b.check("FramebufferRenderbuffer", target, attachment, renderbuffertarget, renderbuffer)
+}
+
+func (b *debugBackend) FramebufferTexture2D(target, attachment, textarget GLEnum, texture Texture, level int32) {
+	b.Backend.FramebufferTexture2D(target, attachment, textarget, texture, level)
+	b.check("FramebufferTexture2D", target, attachment, textarget, texture, level)
+}
+
+func (b *debugBackend) GetFramebufferAttachmentParameteri(target, attachment, pname GLEnum) int32 {
+	r := b.Backend.GetFramebufferAttachmentParameteri(target, attachment, pname)
+	b.check("GetFramebufferAttachmentParameteri", target, attachment, pname)
+	return r
+}
+
+func (b *debugBackend) IsFramebuffer(framebuffer Framebuffer) bool {
+	r := b.Backend.IsFramebuffer(framebuffer)
+	b.check("IsFramebuffer", framebuffer)
+	return r
+}
+
+func (b *debugBackend) ReadBuffer(mode GLEnum) {
+	b.Backend.ReadBuffer(mode)
+	b.check("ReadBuffer", mode)
+}
+
+func (b *debugBackend) ReadPixels(x, y, width, height int32, format, xtype GLEnum, pixels unsafe.Pointer) {
+	b.Backend.ReadPixels(x, y, width, height, format, xtype, pixels)
+	b.check("ReadPixels", x, y, width, height, format, xtype)
+}
+
+func (b *debugBackend) AttachShader(program Program, shader Shader) {
+	b.Backend.AttachShader(program, shader)
+	b.check("AttachShader", program, shader)
+}
+
+func (b *debugBackend) BindAttribLocation(program Program, index uint32, name string) {
+	b.Backend.BindAttribLocation(program, index, name)
+	b.check("BindAttribLocation", program, index, name)
+}
+
+func (b *debugBackend) CompileShader(shader Shader) {
+	b.Backend.CompileShader(shader)
+	b.check("CompileShader", shader)
+}
+
+func (b *debugBackend) CreateProgram() Program {
+	r := b.Backend.CreateProgram()
+	b.check("CreateProgram")
+	return r
+}
+
+func (b *debugBackend) CreateShader(xtype GLEnum) Shader {
+	r := b.Backend.CreateShader(xtype)
+	b.check("CreateShader", xtype)
+	return r
+}
+
+func (b *debugBackend) DeleteProgram(program Program) {
+	b.Backend.DeleteProgram(program)
+	b.check("DeleteProgram", program)
+}
+
+func (b *debugBackend) DeleteShader(shader Shader) {
+	b.Backend.DeleteShader(shader)
+	b.check("DeleteShader", shader)
+}
+
+func (b *debugBackend) DetachShader(program Program, shader Shader) {
+	b.Backend.DetachShader(program, shader)
+	b.check("DetachShader", program, shader)
+}
+
+func (b *debugBackend) GetProgrami(program Program, pname GLEnum) int32 {
+	r := b.Backend.GetProgrami(program, pname)
+	b.check("GetProgrami", program, pname)
+	return r
+}
+
+func (b *debugBackend) GetProgramInfoLog(program Program) string {
+	r := b.Backend.GetProgramInfoLog(program)
+	b.check("GetProgramInfoLog", program)
+	return r
+}
+
+func (b *debugBackend) GetShaderi(shader Shader, pname GLEnum) int32 {
+	r := b.Backend.GetShaderi(shader, pname)
+	b.check("GetShaderi", shader, pname)
+	return r
+}
+
+func (b *debugBackend) GetShaderInfoLog(shader Shader) string {
+	r := b.Backend.GetShaderInfoLog(shader)
+	b.check("GetShaderInfoLog", shader)
+	return r
+}
+
+func (b *debugBackend) IsProgram(program Program) bool {
+	r := b.Backend.IsProgram(program)
+	b.check("IsProgram", program)
+	return r
+}
+
+func (b *debugBackend) IsShader(shader Shader) bool {
+	r := b.Backend.IsShader(shader)
+	b.check("IsShader", shader)
+	return r
+}
+
+func (b *debugBackend) LinkProgram(program Program) {
+	b.Backend.LinkProgram(program)
+	b.check("LinkProgram", program)
+}
+
+func (b *debugBackend) ShaderSource(shader Shader, source string) {
+	b.Backend.ShaderSource(shader, source)
+	b.check("ShaderSource", shader, source)
+}
+
+func (b *debugBackend) UseProgram(program Program) {
+	b.Backend.UseProgram(program)
+	b.check("UseProgram", program)
+}
+
+func (b *debugBackend) ValidateProgram(program Program) {
+	b.Backend.ValidateProgram(program)
+	b.check("ValidateProgram", program)
+}
+
+func (b *debugBackend) BindRenderbuffer(target GLEnum, renderbuffer Renderbuffer) {
+	b.Backend.BindRenderbuffer(target, renderbuffer)
+	b.check("BindRenderbuffer", target, renderbuffer)
+}
+
+func (b *debugBackend) CreateRenderbuffer() Renderbuffer {
+	r := b.Backend.CreateRenderbuffer()
+	b.check("CreateRenderbuffer")
+	return r
+}
+
+func (b *debugBackend) DeleteRenderbuffer(renderbuffer Renderbuffer) {
+	b.Backend.DeleteRenderbuffer(renderbuffer)
+	b.check("DeleteRenderbuffer", renderbuffer)
+}
+
+func (b *debugBackend) GetRenderbufferParameteri(target, pname GLEnum) int32 {
+	r := b.Backend.GetRenderbufferParameteri(target, pname)
+	b.check("GetRenderbufferParameteri", target, pname)
+	return r
+}
+
+func (b *debugBackend) IsRenderbuffer(renderbuffer Renderbuffer) bool {
+	r := b.Backend.IsRenderbuffer(renderbuffer)
+	b.check("IsRenderbuffer", renderbuffer)
+	return r
+}
+
+func (b *debugBackend) RenderbufferStorage(target, internalFormat GLEnum, width, height int32) {
+	b.Backend.RenderbufferStorage(target, internalFormat, width, height)
+	b.check("RenderbufferStorage", target, internalFormat, width, height)
+}
+
+func (b *debugBackend) RenderbufferStorageMultisample(target GLEnum, samples int32, internalFormat GLEnum, width, height int32) {
+	b.Backend.RenderbufferStorageMultisample(target, samples, internalFormat, width, height)
+	b.check("RenderbufferStorageMultisample", target, samples, internalFormat, width, height)
+}
+
+func (b *debugBackend) BindTexture(target GLEnum, texture Texture) {
+	b.Backend.BindTexture(target, texture)
+	b.check("BindTexture", target, texture)
+}
+
+func (b *debugBackend) CompressedTexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border, imageSize int32, pixels unsafe.Pointer) {
+	b.Backend.CompressedTexImage2D(target, level, internalformat, width, height, border, imageSize, pixels)
+	b.check("CompressedTexImage2D", target, level, internalformat, width, height, border, imageSize)
+}
+
+func (b *debugBackend) CompressedTexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format GLEnum, imageSize int32, pixels unsafe.Pointer) {
+	b.Backend.CompressedTexSubImage2D(target, level, xoffset, yoffset, width, height, format, imageSize, pixels)
+	b.check("CompressedTexSubImage2D", target, level, xoffset, yoffset, width, height, format, imageSize)
+}
+
+func (b *debugBackend) CopyTexImage2D(target GLEnum, level int32, internalformat GLEnum, x, y, width, height, border int32) {
+	b.Backend.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
+	b.check("CopyTexImage2D", target, level, internalformat, x, y, width, height, border)
+}
+
+func (b *debugBackend) CopyTexSubImage2D(target GLEnum, level, xoffset, yoffset, x, y, width, height int32) {
+	b.Backend.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, width, height)
+	b.check("CopyTexSubImage2D", target, level, xoffset, yoffset, x, y, width, height)
+}
+
+func (b *debugBackend) CreateTexture() Texture {
+	r := b.Backend.CreateTexture()
+	b.check("CreateTexture")
+	return r
+}
+
+func (b *debugBackend) DeleteTexture(texture Texture) {
+	b.Backend.DeleteTexture(texture)
+	b.check("DeleteTexture", texture)
+}
+
+func (b *debugBackend) GenerateMipmap(target GLEnum) {
+	b.Backend.GenerateMipmap(target)
+	b.check("GenerateMipmap", target)
+}
+
+func (b *debugBackend) IsTexture(texture Texture) bool {
+	r := b.Backend.IsTexture(texture)
+	b.check("IsTexture", texture)
+	return r
+}
+
+func (b *debugBackend) TexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border int32, format, xtype GLEnum, pixels unsafe.Pointer) {
+	b.Backend.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+	b.check("TexImage2D", target, level, internalformat, width, height, border, format, xtype)
+}
+
+func (b *debugBackend) TexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format, xtype GLEnum, pixels unsafe.Pointer) {
+	b.Backend.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, xtype, pixels)
+	b.check("TexSubImage2D", target, level, xoffset, yoffset, width, height, format, xtype)
+}
+
+func (b *debugBackend) TexParameterf(target, pname GLEnum, param float32) {
+	b.Backend.TexParameterf(target, pname, param)
+	b.check("TexParameterf", target, pname, param)
+}
+
+func (b *debugBackend) TexParameteri(target, pname GLEnum, param int32) {
+	b.Backend.TexParameteri(target, pname, param)
+	b.check("TexParameteri", target, pname, param)
+}
+
+func (b *debugBackend) DisableVertexAttribArray(index uint32) {
+	b.Backend.DisableVertexAttribArray(index)
+	b.check("DisableVertexAttribArray", index)
+}
+
+func (b *debugBackend) EnableVertexAttribArray(index uint32) {
+	b.Backend.EnableVertexAttribArray(index)
+	b.check("EnableVertexAttribArray", index)
+}
+
+func (b *debugBackend) GetActiveAttrib(program Program, index uint32) (string, int32, GLEnum) {
+	name, size, xtype := b.Backend.GetActiveAttrib(program, index)
+	b.check("GetActiveAttrib", program, index)
+	return name, size, xtype
+}
+
+func (b *debugBackend) GetActiveUniform(program Program, index uint32) (string, int32, GLEnum) {
+	name, size, xtype := b.Backend.GetActiveUniform(program, index)
+	b.check("GetActiveUniform", program, index)
+	return name, size, xtype
+}
+
+func (b *debugBackend) GetAttribLocation(program Program, name string) int32 {
+	r := b.Backend.GetAttribLocation(program, name)
+	b.check("GetAttribLocation", program, name)
+	return r
+}
+
+func (b *debugBackend) GetUniformLocation(program Program, name string) UniformLocation {
+	r := b.Backend.GetUniformLocation(program, name)
+	b.check("GetUniformLocation", program, name)
+	return r
+}
+
+func (b *debugBackend) Uniform1f(location UniformLocation, v0 float32) {
+	b.Backend.Uniform1f(location, v0)
+	b.check("Uniform1f", location, v0)
+}
+
+func (b *debugBackend) Uniform1fv(location UniformLocation, count int32, value []float32) {
+	b.Backend.Uniform1fv(location, count, value)
+	b.check("Uniform1fv", location, count, value)
+}
+
+func (b *debugBackend) Uniform1i(location UniformLocation, v0 int32) {
+	b.Backend.Uniform1i(location, v0)
+	b.check("Uniform1i", location, v0)
+}
+
+func (b *debugBackend) Uniform1iv(location UniformLocation, count int32, value []int32) {
+	b.Backend.Uniform1iv(location, count, value)
+	b.check("Uniform1iv", location, count, value)
+}
+
+func (b *debugBackend) Uniform2f(location UniformLocation, v0, v1 float32) {
+	b.Backend.Uniform2f(location, v0, v1)
+	b.check("Uniform2f", location, v0, v1)
+}
+
+func (b *debugBackend) Uniform2fv(location UniformLocation, count int32, value []float32) {
+	b.Backend.Uniform2fv(location, count, value)
+	b.check("Uniform2fv", location, count, value)
+}
+
+func (b *debugBackend) Uniform2i(location UniformLocation, v0, v1 int32) {
+	b.Backend.Uniform2i(location, v0, v1)
+	b.check("Uniform2i", location, v0, v1)
+}
+
+func (b *debugBackend) Uniform2iv(location UniformLocation, count int32, value []int32) {
+	b.Backend.Uniform2iv(location, count, value)
+	b.check("Uniform2iv", location, count, value)
+}
+
+func (b *debugBackend) Uniform3f(location UniformLocation, v0, v1, v2 float32) {
+	b.Backend.Uniform3f(location, v0, v1, v2)
+	b.check("Uniform3f", location, v0, v1, v2)
+}
+
+func (b *debugBackend) Uniform3fv(location UniformLocation, count int32, value []float32) {
+	b.Backend.Uniform3fv(location, count, value)
+	b.check("Uniform3fv", location, count, value)
+}
+
+func (b *debugBackend) Uniform3i(location UniformLocation, v0, v1, v2 int32) {
+	b.Backend.Uniform3i(location, v0, v1, v2)
+	b.check("Uniform3i", location, v0, v1, v2)
+}
+
+func (b *debugBackend) Uniform3iv(location UniformLocation, count int32, value []int32) {
+	b.Backend.Uniform3iv(location, count, value)
+	b.check("Uniform3iv", location, count, value)
+}
+
+func (b *debugBackend) Uniform4f(location UniformLocation, v0, v1, v2, v3 float32) {
+	b.Backend.Uniform4f(location, v0, v1, v2, v3)
+	b.check("Uniform4f", location, v0, v1, v2, v3)
+}
+
+func (b *debugBackend) Uniform4fv(location UniformLocation, count int32, value []float32) {
+	b.Backend.Uniform4fv(location, count, value)
+	b.check("Uniform4fv", location, count, value)
+}
+
+func (b *debugBackend) Uniform4i(location UniformLocation, v0, v1, v2, v3 int32) {
+	b.Backend.Uniform4i(location, v0, v1, v2, v3)
+	b.check("Uniform4i", location, v0, v1, v2, v3)
+}
+
+func (b *debugBackend) Uniform4iv(location UniformLocation, count int32, value []int32) {
+	b.Backend.Uniform4iv(location, count, value)
+	b.check("Uniform4iv", location, count, value)
+}
+
+func (b *debugBackend) UniformMatrix2fv(location UniformLocation, count int32, transpose bool, value []float32) {
+	b.Backend.UniformMatrix2fv(location, count, transpose, value)
+	b.check("UniformMatrix2fv", location, count, transpose, value)
+}
+
+func (b *debugBackend) UniformMatrix3fv(location UniformLocation, count int32, transpose bool, value []float32) {
+	b.Backend.UniformMatrix3fv(location, count, transpose, value)
+	b.check("UniformMatrix3fv", location, count, transpose, value)
+}
+
+func (b *debugBackend) UniformMatrix4fv(location UniformLocation, count int32, transpose bool, value []float32) {
+	b.Backend.UniformMatrix4fv(location, count, transpose, value)
+	b.check("UniformMatrix4fv", location, count, transpose, value)
+}
+
+func (b *debugBackend) VertexAttrib1f(index uint32, v0 float32) {
+	b.Backend.VertexAttrib1f(index, v0)
+	b.check("VertexAttrib1f", index, v0)
+}
+
+func (b *debugBackend) VertexAttrib2f(index uint32, v0, v1 float32) {
+	b.Backend.VertexAttrib2f(index, v0, v1)
+	b.check("VertexAttrib2f", index, v0, v1)
+}
+
+func (b *debugBackend) VertexAttrib3f(index uint32, v0, v1, v2 float32) {
+	b.Backend.VertexAttrib3f(index, v0, v1, v2)
+	b.check("VertexAttrib3f", index, v0, v1, v2)
+}
+
+func (b *debugBackend) VertexAttrib4f(index uint32, v0, v1, v2, v3 float32) {
+	b.Backend.VertexAttrib4f(index, v0, v1, v2, v3)
+	b.check("VertexAttrib4f", index, v0, v1, v2, v3)
+}
+
+func (b *debugBackend) VertexAttrib1fv(index uint32, value []float32) {
+	b.Backend.VertexAttrib1fv(index, value)
+	b.check("VertexAttrib1fv", index, value)
+}
+
+func (b *debugBackend) VertexAttrib2fv(index uint32, value []float32) {
+	b.Backend.VertexAttrib2fv(index, value)
+	b.check("VertexAttrib2fv", index, value)
+}
+
+func (b *debugBackend) VertexAttrib3fv(index uint32, value []float32) {
+	b.Backend.VertexAttrib3fv(index, value)
+	b.check("VertexAttrib3fv", index, value)
+}
+
+func (b *debugBackend) VertexAttrib4fv(index uint32, value []float32) {
+	b.Backend.VertexAttrib4fv(index, value)
+	b.check("VertexAttrib4fv", index, value)
+}
+
+func (b *debugBackend) VertexAttribDivisor(index, divisor uint32) {
+	b.Backend.VertexAttribDivisor(index, divisor)
+	b.check("VertexAttribDivisor", index, divisor)
+}
+
+func (b *debugBackend) VertexAttribPointer(index uint32, size int32, xtype GLEnum, normalized bool, stride int32, offset int) {
+	b.Backend.VertexAttribPointer(index, size, xtype, normalized, stride, offset)
+	b.check("VertexAttribPointer", index, size, xtype, normalized, stride, offset)
+}
+
+func (b *debugBackend) BindVertexArray(array VertexArray) {
+	b.Backend.BindVertexArray(array)
+	b.check("BindVertexArray", array)
+}
+
+func (b *debugBackend) CreateVertexArray() VertexArray {
+	r := b.Backend.CreateVertexArray()
+	b.check("CreateVertexArray")
+	return r
+}
+
+func (b *debugBackend) DeleteVertexArray(array VertexArray) {
+	b.Backend.DeleteVertexArray(array)
+	b.check("DeleteVertexArray", array)
+}
+
+func (b *debugBackend) IsVertexArray(array VertexArray) bool {
+	r := b.Backend.IsVertexArray(array)
+	b.check("IsVertexArray", array)
+	return r
+}
+
+func (b *debugBackend) Scissor(x, y, width, height int32) {
+	b.Backend.Scissor(x, y, width, height)
+	b.check("Scissor", x, y, width, height)
+}
+
+func (b *debugBackend) Viewport(x, y, width, height int32) {
+	b.Backend.Viewport(x, y, width, height)
+	b.check("Viewport", x, y, width, height)
+}
@@ -0,0 +1,110 @@
+package gogl_test
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/gogltest"
+)
+
+// initDebugBackend initializes the package with a fake Backend in debug mode,
+// and returns the fake and the errors passed to the handler.
+func initDebugBackend(t *testing.T) (*gogltest.Backend, *[]*gogl.CallError) {
+	t.Helper()
+	b := gogltest.NewBackend()
+	b.Strings[gogl.GLVersion] = "3.3.0 NVIDIA 535.54"
+	var reported []*gogl.CallError
+	handler := func(err *gogl.CallError) { reported = append(reported, err) }
+	if err := gogl.InitWithBackend(gogl.NewDebugBackend(b, handler)); err != nil {
+		t.Fatalf("InitWithBackend() error = %v", err)
+	}
+	return b, &reported
+}
+
+func TestDebugBackend(t *testing.T) {
+	b, reported := initDebugBackend(t)
+	b.Errors = []gogl.GLEnum{gogl.GLInvalidEnum, gogl.GLInvalidValue}
+	gogl.GetMaxSamples()
+
+	want := []*gogl.CallError{
+		{Caller: "GetMaxSamples", Function: "GetIntegerv", Args: []interface{}{gogl.GLMaxSamples, []int32{4}}, Code: gogl.GLInvalidEnum},
+		{Caller: "GetMaxSamples", Function: "GetIntegerv", Args: []interface{}{gogl.GLMaxSamples, []int32{4}}, Code: gogl.GLInvalidValue},
+	}
+	if !reflect.DeepEqual(*reported, want) {
+		t.Errorf("reported errors = %v, want %v", *reported, want)
+	}
+	if len(b.Errors) != 0 {
+		t.Errorf("errors left after the call = %v, want none", b.Errors)
+	}
+
+	// The reported errors are still returned by CheckError, in order.
+	for _, want := range []error{gogl.ErrInvalidEnum, gogl.ErrInvalidValue, nil} {
+		if err := gogl.CheckError(); err != want {
+			t.Errorf("CheckError() = %v, want %v", err, want)
+		}
+	}
+}
+
+func TestDebugBackendCaller(t *testing.T) {
+	b, reported := initDebugBackend(t)
+	b.Errors = []gogl.GLEnum{gogl.GLInvalidOperation}
+	gogl.BindTexture(gogl.GLTexture2D, 7)
+	if len(*reported) != 1 {
+		t.Fatalf("reported errors = %v, want one", *reported)
+	}
+	want := "gogl: BindTexture(GL_TEXTURE_2D, 7): GL_INVALID_OPERATION"
+	if err := (*reported)[0]; err.Caller != "BindTexture" || err.Error() != want {
+		t.Errorf("reported error = %q from %q, want %q from BindTexture", err, err.Caller, want)
+	}
+	if err := gogl.CheckError(); !errors.Is(err, gogl.ErrInvalidOperation) {
+		t.Errorf("CheckError() = %v, want GL_INVALID_OPERATION", err)
+	}
+}
+
+func TestDebugBackendDistinctErrors(t *testing.T) {
+	b, reported := initDebugBackend(t)
+	b.Errors = []gogl.GLEnum{gogl.GLInvalidValue}
+	gogl.Flush()
+	b.Errors = []gogl.GLEnum{gogl.GLInvalidValue}
+	gogl.Finish()
+	if len(*reported) != 2 {
+		t.Errorf("reported errors = %v, want two", *reported)
+	}
+	// Like OpenGL, GetError returns every distinct error once.
+	if code := gogl.GetError(); code != gogl.GLInvalidValue {
+		t.Errorf("GetError() = %v, want GL_INVALID_VALUE", code)
+	}
+	if code := gogl.GetError(); code != gogl.GLNoError {
+		t.Errorf("second GetError() = %v, want GL_NO_ERROR", code)
+	}
+}
+
+func TestDebugBackendContextLost(t *testing.T) {
+	b, reported := initDebugBackend(t)
+	b.Errors = []gogl.GLEnum{gogl.GLContextLost, gogl.GLInvalidOperation}
+	gogl.Flush()
+	if len(*reported) != 1 || (*reported)[0].Code != gogl.GLContextLost {
+		t.Errorf("reported errors = %v, want only GL_CONTEXT_LOST", *reported)
+	}
+}
+
+func TestEnableDebugLogs(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	gogl.EnableDebug(nil)
+	defer gogl.DisableDebug()
+
+	b.Errors = []gogl.GLEnum{gogl.GLOutOfMemory}
+	gogl.Flush()
+	if got := buf.String(); !strings.Contains(got, "gogl: Flush(): GL_OUT_OF_MEMORY") {
+		t.Errorf("log output = %q, want the error of Flush", got)
+	}
+}
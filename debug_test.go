@@ -0,0 +1,34 @@
+package gogl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCallError(t *testing.T) {
+	tests := []struct {
+		err  *CallError
+		want string
+	}{
+		{
+			&CallError{Function: "BindTexture", Args: []interface{}{GLTexture2D, Texture(7)}, Code: GLInvalidOperation},
+			"gogl: BindTexture(GL_TEXTURE_2D, 7): GL_INVALID_OPERATION",
+		},
+		{
+			&CallError{Caller: "BindTexture", Function: "BindTexture", Args: []interface{}{GLTexture2D, Texture(7)}, Code: GLInvalidOperation},
+			"gogl: BindTexture(GL_TEXTURE_2D, 7): GL_INVALID_OPERATION",
+		},
+		{
+			&CallError{Caller: "GetMaxSamples", Function: "GetIntegerv", Args: []interface{}{GLMaxSamples}, Code: GLInvalidEnum},
+			"gogl: GetMaxSamples: GetIntegerv(GL_MAX_SAMPLES): GL_INVALID_ENUM",
+		},
+	}
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("Error() = %q, want %q", got, test.want)
+		}
+		if !errors.Is(test.err, Error(test.err.Code)) {
+			t.Errorf("errors.Is(%v, Error(%v)) = false, want true", test.err, test.err.Code)
+		}
+	}
+}
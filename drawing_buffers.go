@@ -1,7 +1,5 @@
 package gogl
 
-import "github.com/go-gl/gl/v2.1/gl"
-
 // Clear clears buffers to preset values.
 //
 // The preset values can be set by ClearColor, ClearDepth or ClearStencil.
@@ -9,23 +7,62 @@ import "github.com/go-gl/gl/v2.1/gl"
 // The scissor box, dithering, and buffer writemasks can affect the Clear
 // function.
 func Clear(mask GLEnum) {
-	gl.Clear(uint32(mask))
+	backend.Clear(mask)
 }
 
 // DrawArrays renders primitives from array data.
 func DrawArrays(mode GLEnum, first, count int32) {
-	gl.DrawArrays(uint32(mode), first, count)
+	backend.DrawArrays(mode, first, count)
+}
+
+// DrawArraysInstanced renders instanceCount instances of the primitives
+// DrawArrays would render. The instance is available to the vertex shader as
+// gl_InstanceID (gl_InstanceIDARB with GL_ARB_draw_instanced), and advances
+// the vertex attribute arrays with a divisor set by VertexAttribDivisor.
+//
+// It returns ErrInstancingUnsupported without drawing if the context does not
+// support instanced drawing. Support is checked once after Init.
+func DrawArraysInstanced(mode GLEnum, first, count, instanceCount int32) error {
+	if !InstancingSupported() {
+		return ErrInstancingUnsupported
+	}
+	backend.DrawArraysInstanced(mode, first, count, instanceCount)
+	return nil
+}
+
+// DrawElements renders primitives from array data, using the indices stored in
+// the Buffer bound to GLElementArrayBuffer.
+//
+// The indices are of type xtype, which is one of GLUInt8, GLUInt16 or
+// GLUInt32, and count of them are read starting at offset bytes into the
+// buffer. The offset must be a multiple of the size of xtype.
+//
+// An IndexBuffer keeps track of the type and count of its indices, and is less
+// error-prone to draw with.
+func DrawElements(mode GLEnum, count int32, xtype GLEnum, offset int) {
+	backend.DrawElements(mode, count, xtype, offset)
 }
 
-// TODO: DrawElements
+// DrawElementsInstanced renders instanceCount instances of the primitives
+// DrawElements would render, like DrawArraysInstanced.
+//
+// It returns ErrInstancingUnsupported without drawing if the context does not
+// support instanced drawing. Support is checked once after Init.
+func DrawElementsInstanced(mode GLEnum, count int32, xtype GLEnum, offset int, instanceCount int32) error {
+	if !InstancingSupported() {
+		return ErrInstancingUnsupported
+	}
+	backend.DrawElementsInstanced(mode, count, xtype, offset, instanceCount)
+	return nil
+}
 
 // Finish blocks execution until all previously called commands are finished.
 func Finish() {
-	gl.Finish()
+	backend.Finish()
 }
 
 // Flush empties different buffer commands, causing all commands to be executed
 // as quickly as possible.
 func Flush() {
-	gl.Flush()
+	backend.Flush()
 }
@@ -0,0 +1,113 @@
+package gogl
+
+import "encoding/binary"
+
+// decompressDXT decompresses an image of the given dimensions in one of the
+// DXT1, DXT3 or DXT5 formats into tightly packed GLRGBA pixels, keeping the
+// order of the rows. It returns nil if the dimensions are empty or exceed
+// maxTextureDataSize.
+func decompressDXT(format GLEnum, width, height int, data []byte) []byte {
+	if checkTextureDataSize(width, height, 1) != nil {
+		return nil
+	}
+	pixels := make([]byte, width*height*4)
+	size := blockSize(format)
+	blocksX := (width + 3) / 4
+	var block [16][4]byte
+	for by := 0; by < (height+3)/4; by++ {
+		for bx := 0; bx < blocksX; bx++ {
+			offset := (by*blocksX + bx) * size
+			if offset+size > len(data) {
+				return pixels
+			}
+			decodeDXTBlock(format, data[offset:offset+size], &block)
+			for y := 0; y < 4 && by*4+y < height; y++ {
+				for x := 0; x < 4 && bx*4+x < width; x++ {
+					copy(pixels[((by*4+y)*width+bx*4+x)*4:], block[y*4+x][:])
+				}
+			}
+		}
+	}
+	return pixels
+}
+
+// decodeDXTBlock decodes a 4x4 block of the format into RGBA pixels in
+// row-major order.
+func decodeDXTBlock(format GLEnum, data []byte, block *[16][4]byte) {
+	switch format {
+	case GLCompressedRGBS3TCDXT1, GLCompressedSRGBS3TCDXT1:
+		decodeColorBlock(data, block, true, false)
+	case GLCompressedRGBAS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT1:
+		decodeColorBlock(data, block, true, true)
+	case GLCompressedRGBAS3TCDXT3, GLCompressedSRGBAlphaS3TCDXT3:
+		decodeColorBlock(data[8:], block, false, false)
+		alpha := binary.LittleEndian.Uint64(data)
+		for i := range block {
+			block[i][3] = byte(alpha>>(4*i)&0xF) * 17
+		}
+	case GLCompressedRGBAS3TCDXT5, GLCompressedSRGBAlphaS3TCDXT5:
+		decodeColorBlock(data[8:], block, false, false)
+		decodeAlphaBlock(data, block)
+	}
+}
+
+// decodeColorBlock decodes the 8 byte color block of DXT1, DXT3 and DXT5 into
+// the RGB components of block, setting the alpha to 255. If dxt1 is true,
+// blocks whose first color is not greater than the second use the three color
+// mode, whose fourth color is black, and transparent if transparent is true.
+func decodeColorBlock(data []byte, block *[16][4]byte, dxt1, transparent bool) {
+	c0 := binary.LittleEndian.Uint16(data)
+	c1 := binary.LittleEndian.Uint16(data[2:])
+	var colors [4][4]byte
+	colors[0] = rgb565(c0)
+	colors[1] = rgb565(c1)
+	if !dxt1 || c0 > c1 {
+		for i := 0; i < 3; i++ {
+			colors[2][i] = byte((2*int(colors[0][i]) + int(colors[1][i])) / 3)
+			colors[3][i] = byte((int(colors[0][i]) + 2*int(colors[1][i])) / 3)
+		}
+		colors[2][3], colors[3][3] = 255, 255
+	} else {
+		for i := 0; i < 3; i++ {
+			colors[2][i] = byte((int(colors[0][i]) + int(colors[1][i])) / 2)
+		}
+		colors[2][3] = 255
+		if !transparent {
+			colors[3][3] = 255
+		}
+	}
+	indices := binary.LittleEndian.Uint32(data[4:])
+	for i := range block {
+		block[i] = colors[indices>>(2*i)&3]
+	}
+}
+
+// decodeAlphaBlock decodes the 8 byte interpolated alpha block of DXT5 into
+// the alpha components of block.
+func decodeAlphaBlock(data []byte, block *[16][4]byte) {
+	var alphas [8]int
+	alphas[0], alphas[1] = int(data[0]), int(data[1])
+	if alphas[0] > alphas[1] {
+		for i := 1; i < 7; i++ {
+			alphas[i+1] = ((7-i)*alphas[0] + i*alphas[1]) / 7
+		}
+	} else {
+		for i := 1; i < 5; i++ {
+			alphas[i+1] = ((5-i)*alphas[0] + i*alphas[1]) / 5
+		}
+		alphas[6], alphas[7] = 0, 255
+	}
+	var bits uint64
+	for i := 0; i < 6; i++ {
+		bits |= uint64(data[2+i]) << (8 * i)
+	}
+	for i := range block {
+		block[i][3] = byte(alphas[bits>>(3*i)&7])
+	}
+}
+
+// rgb565 expands a 16 bit 5:6:5 color to an opaque RGBA color.
+func rgb565(c uint16) [4]byte {
+	r, g, b := c>>11&0x1F, c>>5&0x3F, c&0x1F
+	return [4]byte{byte(r<<3 | r>>2), byte(g<<2 | g>>4), byte(b<<3 | b>>2), 255}
+}
@@ -0,0 +1,98 @@
+package gogl
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecompressDXT(t *testing.T) {
+	var (
+		red     = []byte{255, 0, 0, 255}
+		blue    = []byte{0, 0, 255, 255}
+		white   = []byte{255, 255, 255, 255}
+		black   = []byte{0, 0, 0, 255}
+		clear   = []byte{0, 0, 0, 0}
+		purple  = []byte{127, 0, 127, 255}
+		reddish = []byte{170, 0, 85, 255}
+		bluish  = []byte{85, 0, 170, 255}
+	)
+	// colors is a color block with the colors c0 and c1 whose first four
+	// pixels use the indices 0 to 3 and the remaining ones the index 0.
+	colors := func(c0, c1 uint16) []byte {
+		return []byte{byte(c0), byte(c0 >> 8), byte(c1), byte(c1 >> 8), 0xE4, 0, 0, 0}
+	}
+	tests := []struct {
+		name   string
+		format GLEnum
+		data   []byte
+		want   [4][]byte
+	}{
+		{
+			"DXT1 four colors",
+			GLCompressedRGBAS3TCDXT1,
+			colors(0xF800, 0x001F),
+			[4][]byte{red, blue, reddish, bluish},
+		},
+		{
+			"DXT1 three colors and transparent",
+			GLCompressedRGBAS3TCDXT1,
+			colors(0x001F, 0xF800),
+			[4][]byte{blue, red, purple, clear},
+		},
+		{
+			"DXT1 three colors and black",
+			GLCompressedRGBS3TCDXT1,
+			colors(0x001F, 0xF800),
+			[4][]byte{blue, red, purple, black},
+		},
+		{
+			"DXT3",
+			GLCompressedRGBAS3TCDXT3,
+			append([]byte{0xF0, 0x8, 0, 0, 0, 0, 0, 0}, colors(0xFFFF, 0xFFFF)...),
+			[4][]byte{{255, 255, 255, 0}, white, {255, 255, 255, 136}, {255, 255, 255, 0}},
+		},
+		{
+			"DXT5 eight alphas",
+			GLCompressedRGBAS3TCDXT5,
+			append([]byte{255, 0, 0x88, 0x06, 0, 0, 0, 0}, colors(0xFFFF, 0xFFFF)...),
+			[4][]byte{white, {255, 255, 255, 0}, {255, 255, 255, 218}, {255, 255, 255, 182}},
+		},
+		{
+			"DXT5 six alphas",
+			GLCompressedRGBAS3TCDXT5,
+			append([]byte{0, 255, 0x88, 0x06, 0, 0, 0, 0}, colors(0xFFFF, 0xFFFF)...),
+			[4][]byte{{255, 255, 255, 0}, white, {255, 255, 255, 51}, {255, 255, 255, 102}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pixels := decompressDXT(test.format, 4, 4, test.data)
+			if len(pixels) != 4*4*4 {
+				t.Fatalf("decompressDXT() returned %d bytes, want %d", len(pixels), 4*4*4)
+			}
+			for i, want := range test.want {
+				if got := pixels[i*4 : i*4+4]; !bytes.Equal(got, want) {
+					t.Errorf("pixel %d = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecompressDXTPartialBlock(t *testing.T) {
+	// The pixel 1 of the block is blue and all others are red.
+	data := []byte{0x00, 0xF8, 0x1F, 0x00, 0x04, 0x00, 0x00, 0x00}
+	pixels := decompressDXT(GLCompressedRGBS3TCDXT1, 2, 2, data)
+	want := []byte{
+		255, 0, 0, 255, 0, 0, 255, 255,
+		255, 0, 0, 255, 255, 0, 0, 255,
+	}
+	if !bytes.Equal(pixels, want) {
+		t.Errorf("decompressDXT() = %v, want %v", pixels, want)
+	}
+
+	// Missing blocks are left transparent black.
+	if pixels := decompressDXT(GLCompressedRGBS3TCDXT1, 8, 4, data); len(pixels) != 8*4*4 || !bytes.Equal(pixels[16:32], make([]byte, 16)) {
+		t.Errorf("decompressDXT() of a truncated image = %v, want the second block to be zero", pixels)
+	}
+}
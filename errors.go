@@ -0,0 +1,41 @@
+package gogl
+
+import "errors"
+
+// Error is an OpenGL error as returned by GetError. It implements the error
+// interface, so it can be compared with errors.Is, e.g.,
+// errors.Is(err, gogl.ErrInvalidOperation).
+type Error GLEnum
+
+// Errors returned by CheckError, and wrapped by the *CallError values reported
+// in debug mode.
+var (
+	ErrInvalidEnum                 = Error(GLInvalidEnum)
+	ErrInvalidValue                = Error(GLInvalidValue)
+	ErrInvalidOperation            = Error(GLInvalidOperation)
+	ErrInvalidFramebufferOperation = Error(GLInvalidFramebufferOperation)
+	ErrOutOfMemory                 = Error(GLOutOfMemory)
+	ErrContextLost                 = Error(GLContextLost)
+)
+
+// Error returns the OpenGL name of the error, e.g., "GL_INVALID_OPERATION".
+func (err Error) Error() string {
+	if GLEnum(err) == GLNoError {
+		return "GL_NO_ERROR"
+	}
+	return GLEnum(err).String()
+}
+
+// CheckError returns the error returned by GetError as an Error, or nil if
+// GetError returns GLNoError.
+func CheckError() error {
+	if code := GetError(); code != GLNoError {
+		return Error(code)
+	}
+	return nil
+}
+
+// ErrInstancingUnsupported is returned by DrawArraysInstanced,
+// DrawElementsInstanced and VertexAttribDivisor if the context supports
+// neither OpenGL 3.3 nor GL_ARB_draw_instanced and GL_ARB_instanced_arrays.
+var ErrInstancingUnsupported = errors.New("gogl: instanced drawing requires OpenGL 3.3 or GL_ARB_draw_instanced and GL_ARB_instanced_arrays")
@@ -0,0 +1,19 @@
+package gogl
+
+import "testing"
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		err  Error
+		want string
+	}{
+		{ErrInvalidOperation, "GL_INVALID_OPERATION"},
+		{ErrOutOfMemory, "GL_OUT_OF_MEMORY"},
+		{Error(GLNoError), "GL_NO_ERROR"},
+	}
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("Error(0x%04X).Error() = %q, want %q", uint32(test.err), got, test.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package gogl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FramebufferAttachment describes what is attached to an attachment point of
+// a Framebuffer, as returned by GetFramebufferAttachmentParameter.
+type FramebufferAttachment struct {
+	// Attachment is the attachment point, e.g., GLColorAttachment0.
+	Attachment GLEnum
+	// ObjectType is GLTexture, GLRenderbuffer, or GLNone if nothing is
+	// attached.
+	ObjectType GLEnum
+	// ObjectName is the name of the attached Texture or Renderbuffer.
+	ObjectName uint32
+	// TextureLevel is the attached mipmap level of a Texture.
+	TextureLevel int32
+	// TextureCubeMapFace is the attached face of a cube map Texture, e.g.,
+	// GLTextureCubeMapPositiveX, or 0 if the Texture is not a cube map.
+	TextureCubeMapFace GLEnum
+}
+
+// GetFramebufferAttachment returns what is attached to the attachment point of
+// the Framebuffer bound to target.
+func GetFramebufferAttachment(target, attachment GLEnum) FramebufferAttachment {
+	info := FramebufferAttachment{
+		Attachment: attachment,
+		ObjectType: GLEnum(GetFramebufferAttachmentParameter(target, attachment, GLFramebufferAttachmentObjectType)),
+	}
+	if info.ObjectType == GLNone {
+		return info
+	}
+	info.ObjectName = uint32(GetFramebufferAttachmentParameter(target, attachment, GLFramebufferAttachmentObjectName))
+	if info.ObjectType == GLTexture {
+		info.TextureLevel = GetFramebufferAttachmentParameter(target, attachment, GLFramebufferAttachmentTextureLevel)
+		info.TextureCubeMapFace = GLEnum(GetFramebufferAttachmentParameter(target, attachment, GLFramebufferAttachmentTextureCubeMapFace))
+	}
+	return info
+}
+
+// String returns a description of the attachment, e.g.,
+// "GL_COLOR_ATTACHMENT0: texture 3, level 0".
+func (info FramebufferAttachment) String() string {
+	switch info.ObjectType {
+	case GLNone:
+		return fmt.Sprintf("%v: none", info.Attachment)
+	case GLTexture:
+		description := fmt.Sprintf("%v: texture %d, level %d", info.Attachment, info.ObjectName, info.TextureLevel)
+		if info.TextureCubeMapFace != 0 {
+			description += fmt.Sprintf(", face %v", info.TextureCubeMapFace)
+		}
+		return description
+	case GLRenderbuffer:
+		return fmt.Sprintf("%v: renderbuffer %d", info.Attachment, info.ObjectName)
+	}
+	return fmt.Sprintf("%v: %v %d", info.Attachment, info.ObjectType, info.ObjectName)
+}
+
+// FramebufferError is returned by ValidateFramebuffer if a Framebuffer is not
+// complete.
+type FramebufferError struct {
+	// Framebuffer is the Framebuffer that was bound to the target.
+	Framebuffer Framebuffer
+	// Status is the status returned by CheckFramebufferStatus.
+	Status GLEnum
+	// Attachments are the attachment points of the Framebuffer and what is
+	// attached to them: GLColorAttachment0, the other color attachments that
+	// are in use, GLDepthAttachment and GLStencilAttachment. It is empty for
+	// the default framebuffer.
+	Attachments []FramebufferAttachment
+}
+
+// Error returns the status, its likely cause and the attachments of the
+// Framebuffer.
+func (err *FramebufferError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "gogl: framebuffer %d is incomplete: %v", err.Framebuffer, err.Status)
+	if reason := framebufferStatusReason(err.Status); reason != "" {
+		fmt.Fprintf(&b, " (%s)", reason)
+	}
+	for _, attachment := range err.Attachments {
+		b.WriteString("; ")
+		b.WriteString(attachment.String())
+	}
+	return b.String()
+}
+
+// ValidateFramebuffer checks the completeness of the Framebuffer bound to
+// target with CheckFramebufferStatus. It returns nil if it is complete, and a
+// *FramebufferError describing the status and every attachment point
+// otherwise.
+func ValidateFramebuffer(target GLEnum) error {
+	status := CheckFramebufferStatus(target)
+	if status == GLFramebufferComplete {
+		return nil
+	}
+	err := &FramebufferError{Framebuffer: GetDrawFramebufferBinding(), Status: status}
+	if target == GLReadFramebuffer {
+		err.Framebuffer = GetReadFramebufferBinding()
+	}
+	if err.Framebuffer == 0 {
+		return err
+	}
+	err.Attachments = append(err.Attachments, GetFramebufferAttachment(target, GLColorAttachment0))
+	colorAttachments := GetMaxColorAttachments()
+	if colorAttachments > 16 {
+		colorAttachments = 16
+	}
+	for i := int32(1); i < colorAttachments; i++ {
+		if info := GetFramebufferAttachment(target, GLColorAttachment0+GLEnum(i)); info.ObjectType != GLNone {
+			err.Attachments = append(err.Attachments, info)
+		}
+	}
+	for _, attachment := range []GLEnum{GLDepthAttachment, GLStencilAttachment} {
+		err.Attachments = append(err.Attachments, GetFramebufferAttachment(target, attachment))
+	}
+	return err
+}
+
+// framebufferStatusReason returns the likely cause of an incomplete status, or
+// "" if it is unknown.
+func framebufferStatusReason(status GLEnum) string {
+	switch status {
+	case GLFramebufferIncompleteAttachment:
+		return "an attachment has no storage, a size of 0, or a format that cannot be rendered to"
+	case GLFramebufferIncompleteMissingAttachment:
+		return "nothing is attached"
+	case GLFramebufferIncompleteDimensions:
+		return "the attachments differ in size"
+	case GLFramebufferIncompleteDrawBuffer:
+		return "a draw buffer has no attachment"
+	case GLFramebufferIncompleteReadBuffer:
+		return "the read buffer has no attachment"
+	case GLFramebufferIncompleteMultisample:
+		return "the attachments differ in the number of samples"
+	case GLFramebufferUnsupported:
+		return "the combination of formats is not supported by the implementation"
+	case GLFramebufferUndefined:
+		return "the default framebuffer does not exist"
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package gogl_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestValidateFramebuffer(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	if err := gogl.ValidateFramebuffer(gogl.GLFramebuffer); err != nil {
+		t.Fatalf("ValidateFramebuffer() of a complete framebuffer = %v, want nil", err)
+	}
+
+	framebuffer := gogl.CreateFramebuffer()
+	color, normal := gogl.CreateTexture(), gogl.CreateTexture()
+	depth := gogl.CreateRenderbuffer()
+	gogl.BindFramebuffer(gogl.GLFramebuffer, framebuffer)
+	gogl.FramebufferTexture2D(gogl.GLFramebuffer, gogl.GLColorAttachment0, gogl.GLTexture2D, color, 0)
+	gogl.FramebufferTexture2D(gogl.GLFramebuffer, gogl.GLColorAttachment2, gogl.GLTextureCubeMapPositiveZ, normal, 1)
+	gogl.FramebufferRenderbuffer(gogl.GLFramebuffer, gogl.GLDepthAttachment, gogl.GLRenderbuffer, depth)
+	b.FramebufferStatus = gogl.GLFramebufferIncompleteAttachment
+
+	err := gogl.ValidateFramebuffer(gogl.GLFramebuffer)
+	var framebufferErr *gogl.FramebufferError
+	if !errors.As(err, &framebufferErr) {
+		t.Fatalf("ValidateFramebuffer() = %v, want a *FramebufferError", err)
+	}
+	want := "gogl: framebuffer 1 is incomplete: GL_FRAMEBUFFER_INCOMPLETE_ATTACHMENT" +
+		" (an attachment has no storage, a size of 0, or a format that cannot be rendered to)" +
+		"; GL_COLOR_ATTACHMENT0: texture 1, level 0" +
+		"; GL_COLOR_ATTACHMENT2: texture 2, level 1, face GL_TEXTURE_CUBE_MAP_POSITIVE_Z" +
+		"; GL_DEPTH_ATTACHMENT: renderbuffer 1" +
+		"; GL_STENCIL_ATTACHMENT: none"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if framebufferErr.Framebuffer != framebuffer || framebufferErr.Status != gogl.GLFramebufferIncompleteAttachment {
+		t.Errorf("FramebufferError = %+v, want framebuffer %d", framebufferErr, framebuffer)
+	}
+}
+
+func TestValidateFramebufferDefault(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	b.FramebufferStatus = gogl.GLFramebufferUndefined
+	err := gogl.ValidateFramebuffer(gogl.GLReadFramebuffer)
+	want := "gogl: framebuffer 0 is incomplete: GL_FRAMEBUFFER_UNDEFINED (the default framebuffer does not exist)"
+	if err == nil || err.Error() != want {
+		t.Errorf("ValidateFramebuffer() = %v, want %q", err, want)
+	}
+	if calls := b.CallsTo("GetFramebufferAttachmentParameteri"); calls != nil {
+		t.Errorf("ValidateFramebuffer() queried the attachments of the default framebuffer: %v", calls)
+	}
+}
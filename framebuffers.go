@@ -1,49 +1,118 @@
 package gogl
 
-import "github.com/go-gl/gl/v2.1/gl"
+import (
+	"fmt"
+	"unsafe"
+)
 
-// BindFramebuffer binds a given Framebuffer to a target.
+// BindFramebuffer binds a given Framebuffer to a target. GLFramebuffer binds
+// it to both, GLReadFramebuffer, which is read from by ReadPixels and
+// BlitFramebuffer, and GLDrawFramebuffer, which is rendered to.
 func BindFramebuffer(target GLEnum, framebuffer Framebuffer) {
-	gl.BindFramebuffer(uint32(target), uint32(framebuffer))
+	backend.BindFramebuffer(target, framebuffer)
+}
+
+// BlitFramebuffer copies a rectangle of pixels from the Framebuffer bound to
+// GLReadFramebuffer into a rectangle of the Framebuffer bound to
+// GLDrawFramebuffer. The rectangles are given by their corners x0, y0 and x1,
+// y1, and the pixels are scaled if they differ in size.
+//
+// The mask is a bitwise OR of GLColorBufferBit, GLDepthBufferBit and
+// GLStencilBufferBit, and selects the buffers that are copied. Colors are
+// read from the ReadBuffer and written to every DrawBuffers. The filter is
+// GLNearest or GLLinear, which is only valid for colors. Blitting from a
+// multisampled Framebuffer resolves its samples, which requires rectangles of
+// the same size.
+func BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int32, mask, filter GLEnum) {
+	backend.BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
 }
 
 // CheckFramebufferStatus returns the completeness status of the Framebuffer
 // object.
 func CheckFramebufferStatus(target GLEnum) GLEnum {
-	return GLEnum(gl.CheckFramebufferStatus(uint32(target)))
+	return GLEnum(backend.CheckFramebufferStatus(target))
 }
 
 // CreateFramebuffer creates and initializes a Framebuffer object.
 func CreateFramebuffer() Framebuffer {
-	var framebuffer uint32
-	gl.GenFramebuffers(1, &framebuffer)
-	return Framebuffer(framebuffer)
+	return backend.CreateFramebuffer()
 }
 
 // Delete deletes the Framebuffer object. This function has no effect if the
 // frame buffer has already been deleted.
 func (framebuffer Framebuffer) Delete() {
-	// TODO: Is it somehow possible to get &uint32(framebuffer) without assigning it to framebuffers?
-	framebuffers := uint32(framebuffer)
-	gl.DeleteFramebuffers(1, &framebuffers)
+	backend.DeleteFramebuffer(framebuffer)
+}
+
+// DrawBuffer specifies the color buffer the fragment shader output is written
+// to, e.g., GLBack for the default framebuffer. GLNone discards the colors,
+// which is required for a Framebuffer without color attachments before
+// OpenGL 4.1, as it is incomplete otherwise.
+func DrawBuffer(mode GLEnum) {
+	backend.DrawBuffer(mode)
+}
+
+// DrawBuffers specifies the color buffers the fragment shader outputs are
+// written to, e.g., GLColorAttachment0 and GLColorAttachment1 for a
+// Framebuffer with multiple render targets. The output i is written to
+// buffers[i], or discarded if it is GLNone. The number of buffers must not
+// exceed GetMaxDrawBuffers.
+func DrawBuffers(buffers []GLEnum) {
+	backend.DrawBuffers(buffers)
 }
 
 // FramebufferRenderbuffer attaches a Renderbuffer object to a Framebuffer
 // object.
 func FramebufferRenderbuffer(target, attachment, renderbuffertarget GLEnum, renderbuffer Renderbuffer) {
-	gl.FramebufferRenderbuffer(uint32(target), uint32(attachment), uint32(renderbuffertarget), uint32(renderbuffer))
+	backend.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
 }
 
 // FramebufferTexture2D attaches a texture to a Framebuffer.
 func FramebufferTexture2D(target, attachment, textarget GLEnum, texture Texture, level int32) {
-	gl.FramebufferTexture2D(uint32(target), uint32(attachment), uint32(textarget), uint32(texture), level)
+	backend.FramebufferTexture2D(target, attachment, textarget, texture, level)
 }
 
-// TODO: GetFramebufferAttachmentParameter
+// GetFramebufferAttachmentParameter returns a parameter of an attachment of
+// the Framebuffer bound to target, e.g., GLFramebufferAttachmentObjectType.
+func GetFramebufferAttachmentParameter(target, attachment, pname GLEnum) int32 {
+	return backend.GetFramebufferAttachmentParameteri(target, attachment, pname)
+}
 
 // IsFramebuffer returns true if the Framebuffer is valid and false otherwise.
 func (framebuffer Framebuffer) IsFramebuffer() bool {
-	return gl.IsFramebuffer(uint32(framebuffer))
+	return backend.IsFramebuffer(framebuffer)
+}
+
+// ReadBuffer specifies the color buffer ReadPixels reads from, e.g.,
+// GLColorAttachment1, or GLBack for the default framebuffer.
+func ReadBuffer(mode GLEnum) {
+	backend.ReadBuffer(mode)
 }
 
-// TODO: ReadPixels
+// ReadPixels reads a block of pixels of the given dimensions, whose lower left
+// corner is at x, y, from the current framebuffer into pixels.
+//
+// The pixels are stored in the given format and type, e.g., GLRGBA and
+// GLUInt8, in bottom-up order. Every row starts at a multiple of
+// GetPackAlignment bytes. ReadPixels panics if pixels is too small to hold
+// the block.
+func ReadPixels(x, y, width, height int32, format, xtype GLEnum, pixels []byte) {
+	size := imageDataSize("ReadPixels", width, height, format, xtype, GetPackAlignment())
+	if len(pixels) < size {
+		panic(fmt.Sprintf("gogl: ReadPixels: %d bytes are too few for %dx%d pixels", len(pixels), width, height))
+	}
+	if size > 0 {
+		backend.ReadPixels(x, y, width, height, format, xtype, unsafe.Pointer(&pixels[0]))
+	}
+}
+
+// ReadPixelsFloat is like ReadPixels, but reads pixels of type GLFloat32.
+func ReadPixelsFloat(x, y, width, height int32, format GLEnum, pixels []float32) {
+	size := imageDataSize("ReadPixelsFloat", width, height, format, GLFloat32, GetPackAlignment())
+	if len(pixels)*4 < size {
+		panic(fmt.Sprintf("gogl: ReadPixelsFloat: %d floats are too few for %dx%d pixels", len(pixels), width, height))
+	}
+	if size > 0 {
+		backend.ReadPixels(x, y, width, height, format, GLFloat32, unsafe.Pointer(&pixels[0]))
+	}
+}
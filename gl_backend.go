@@ -0,0 +1,652 @@
+package gogl
+
+import (
+	"unsafe"
+
+	"github.com/go-gl/gl/v2.1/gl"
+)
+
+// glBackend is the Backend forwarding every call to the OpenGL 2.1 bindings of
+// github.com/go-gl/gl.
+type glBackend struct {
+	getProcAddr func(name string) unsafe.Pointer
+}
+
+// NewGLBackend returns the default Backend, which forwards every call to the
+// OpenGL 2.1 bindings of github.com/go-gl/gl.
+func NewGLBackend() Backend {
+	return glBackend{}
+}
+
+// NewGLBackendWithProcAddrFunc returns a Backend like NewGLBackend, but one
+// that loads the function pointers using getProcAddr instead of the platform's
+// default (WGL, CGL or GLX), e.g., for contexts created through EGL.
+func NewGLBackendWithProcAddrFunc(getProcAddr func(name string) unsafe.Pointer) Backend {
+	return glBackend{getProcAddr: getProcAddr}
+}
+
+func (b glBackend) Init() error {
+	if b.getProcAddr != nil {
+		return gl.InitWithProcAddrFunc(b.getProcAddr)
+	}
+	return gl.Init()
+}
+
+func (glBackend) GetString(name GLEnum) string {
+	return gl.GoStr(gl.GetString(uint32(name)))
+}
+
+func (glBackend) GetError() GLEnum {
+	return GLEnum(gl.GetError())
+}
+
+func (glBackend) BindBuffer(target GLEnum, buffer Buffer) {
+	gl.BindBuffer(uint32(target), uint32(buffer))
+}
+
+func (glBackend) BufferData(target GLEnum, size int, data unsafe.Pointer, usage GLEnum) {
+	gl.BufferData(uint32(target), size, data, uint32(usage))
+}
+
+func (glBackend) BufferSubData(target GLEnum, offset, size int, data unsafe.Pointer) {
+	gl.BufferSubData(uint32(target), offset, size, data)
+}
+
+func (glBackend) CreateBuffer() Buffer {
+	var buffer uint32
+	gl.GenBuffers(1, &buffer)
+	return Buffer(buffer)
+}
+
+func (glBackend) DeleteBuffer(buffer Buffer) {
+	buffers := uint32(buffer)
+	gl.DeleteBuffers(1, &buffers)
+}
+
+func (glBackend) GetBufferParameteri(target, pname GLEnum) int32 {
+	var params int32
+	gl.GetBufferParameteriv(uint32(target), uint32(pname), &params)
+	return params
+}
+
+func (glBackend) IsBuffer(buffer Buffer) bool {
+	return gl.IsBuffer(uint32(buffer))
+}
+
+func (glBackend) ActiveTexture(texture GLEnum) {
+	gl.ActiveTexture(uint32(texture))
+}
+
+func (glBackend) BlendColor(red, green, blue, alpha float32) {
+	gl.BlendColor(red, green, blue, alpha)
+}
+
+func (glBackend) BlendEquation(mode GLEnum) {
+	gl.BlendEquation(uint32(mode))
+}
+
+func (glBackend) BlendEquationSeparate(modeRGB, modeAlpha GLEnum) {
+	gl.BlendEquationSeparate(uint32(modeRGB), uint32(modeAlpha))
+}
+
+func (glBackend) BlendFunc(sfactor, dfactor GLEnum) {
+	gl.BlendFunc(uint32(sfactor), uint32(dfactor))
+}
+
+func (glBackend) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLEnum) {
+	gl.BlendFuncSeparate(uint32(srcRGB), uint32(dstRGB), uint32(srcAlpha), uint32(dstAlpha))
+}
+
+func (glBackend) ClearColor(red, green, blue, alpha float32) {
+	gl.ClearColor(red, green, blue, alpha)
+}
+
+func (glBackend) ClearDepth(depth float32) {
+	gl.ClearDepth(float64(depth))
+}
+
+func (glBackend) ClearStencil(s int32) {
+	gl.ClearStencil(s)
+}
+
+func (glBackend) ColorMask(red, green, blue, alpha bool) {
+	gl.ColorMask(red, green, blue, alpha)
+}
+
+func (glBackend) CullFace(mode GLEnum) {
+	gl.CullFace(uint32(mode))
+}
+
+func (glBackend) DepthFunc(xfunc GLEnum) {
+	gl.DepthFunc(uint32(xfunc))
+}
+
+func (glBackend) DepthMask(flag bool) {
+	gl.DepthMask(flag)
+}
+
+func (glBackend) DepthRange(zNear, zFar float32) {
+	gl.DepthRange(float64(zNear), float64(zFar))
+}
+
+func (glBackend) Disable(cap GLEnum) {
+	gl.Disable(uint32(cap))
+}
+
+func (glBackend) Enable(cap GLEnum) {
+	gl.Enable(uint32(cap))
+}
+
+func (glBackend) FrontFace(mode GLEnum) {
+	gl.FrontFace(uint32(mode))
+}
+
+func (glBackend) GetBooleanv(pname GLEnum, data []bool) {
+	gl.GetBooleanv(uint32(pname), &data[0])
+}
+
+func (glBackend) GetFloatv(pname GLEnum, data []float32) {
+	gl.GetFloatv(uint32(pname), &data[0])
+}
+
+func (glBackend) GetIntegerv(pname GLEnum, data []int32) {
+	gl.GetIntegerv(uint32(pname), &data[0])
+}
+
+func (glBackend) Hint(target, mode GLEnum) {
+	gl.Hint(uint32(target), uint32(mode))
+}
+
+func (glBackend) IsEnabled(cap GLEnum) bool {
+	return gl.IsEnabled(uint32(cap))
+}
+
+func (glBackend) LineWidth(width float32) {
+	gl.LineWidth(width)
+}
+
+func (glBackend) PixelStorei(pname GLEnum, param int32) {
+	gl.PixelStorei(uint32(pname), param)
+}
+
+func (glBackend) PolygonOffset(factor, units float32) {
+	gl.PolygonOffset(factor, units)
+}
+
+func (glBackend) SampleCoverage(value float32, invert bool) {
+	gl.SampleCoverage(value, invert)
+}
+
+func (glBackend) StencilFunc(xfunc GLEnum, ref int32, mask uint32) {
+	gl.StencilFunc(uint32(xfunc), ref, mask)
+}
+
+func (glBackend) StencilFuncSeparate(face, xfunc GLEnum, ref int32, mask uint32) {
+	gl.StencilFuncSeparate(uint32(face), uint32(xfunc), ref, mask)
+}
+
+func (glBackend) StencilMask(mask uint32) {
+	gl.StencilMask(mask)
+}
+
+func (glBackend) StencilMaskSeparate(face GLEnum, mask uint32) {
+	gl.StencilMaskSeparate(uint32(face), mask)
+}
+
+func (glBackend) StencilOp(fail, zfail, zpass GLEnum) {
+	gl.StencilOp(uint32(fail), uint32(zfail), uint32(zpass))
+}
+
+func (glBackend) StencilOpSeparate(face, fail, zfail, zpass GLEnum) {
+	gl.StencilOpSeparate(uint32(face), uint32(fail), uint32(zfail), uint32(zpass))
+}
+
+func (glBackend) Clear(mask GLEnum) {
+	gl.Clear(uint32(mask))
+}
+
+func (glBackend) DrawArrays(mode GLEnum, first, count int32) {
+	gl.DrawArrays(uint32(mode), first, count)
+}
+
+func (glBackend) DrawArraysInstanced(mode GLEnum, first, count, instanceCount int32) {
+	gl.DrawArraysInstancedARB(uint32(mode), first, count, instanceCount)
+}
+
+func (glBackend) DrawElements(mode GLEnum, count int32, xtype GLEnum, offset int) {
+	gl.DrawElements(uint32(mode), count, uint32(xtype), gl.PtrOffset(offset))
+}
+
+func (glBackend) DrawElementsInstanced(mode GLEnum, count int32, xtype GLEnum, offset int, instanceCount int32) {
+	gl.DrawElementsInstancedARB(uint32(mode), count, uint32(xtype), gl.PtrOffset(offset), instanceCount)
+}
+
+func (glBackend) Finish() {
+	gl.Finish()
+}
+
+func (glBackend) Flush() {
+	gl.Flush()
+}
+
+func (glBackend) BindFramebuffer(target GLEnum, framebuffer Framebuffer) {
+	gl.BindFramebuffer(uint32(target), uint32(framebuffer))
+}
+
+func (glBackend) BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int32, mask, filter GLEnum) {
+	gl.BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, uint32(mask), uint32(filter))
+}
+
+func (glBackend) CheckFramebufferStatus(target GLEnum) GLEnum {
+	return GLEnum(gl.CheckFramebufferStatus(uint32(target)))
+}
+
+func (glBackend) CreateFramebuffer() Framebuffer {
+	var framebuffer uint32
+	gl.GenFramebuffers(1, &framebuffer)
+	return Framebuffer(framebuffer)
+}
+
+func (glBackend) DeleteFramebuffer(framebuffer Framebuffer) {
+	framebuffers := uint32(framebuffer)
+	gl.DeleteFramebuffers(1, &framebuffers)
+}
+
+func (glBackend) DrawBuffer(mode GLEnum) {
+	gl.DrawBuffer(uint32(mode))
+}
+
+func (glBackend) DrawBuffers(buffers []GLEnum) {
+	var bufs *uint32
+	if len(buffers) > 0 {
+		bufs = (*uint32)(unsafe.Pointer(&buffers[0]))
+	}
+	gl.DrawBuffers(int32(len(buffers)), bufs)
+}
+
+func (glBackend) FramebufferRenderbuffer(target, attachment, renderbuffertarget GLEnum, renderbuffer Renderbuffer) {
+	gl.FramebufferRenderbuffer(uint32(target), uint32(attachment), uint32(renderbuffertarget), uint32(renderbuffer))
+}
+
+func (glBackend) FramebufferTexture2D(target, attachment, textarget GLEnum, texture Texture, level int32) {
+	gl.FramebufferTexture2D(uint32(target), uint32(attachment), uint32(textarget), uint32(texture), level)
+}
+
+func (glBackend) GetFramebufferAttachmentParameteri(target, attachment, pname GLEnum) int32 {
+	var params int32
+	gl.GetFramebufferAttachmentParameteriv(uint32(target), uint32(attachment), uint32(pname), &params)
+	return params
+}
+
+func (glBackend) IsFramebuffer(framebuffer Framebuffer) bool {
+	return gl.IsFramebuffer(uint32(framebuffer))
+}
+
+func (glBackend) ReadBuffer(mode GLEnum) {
+	gl.ReadBuffer(uint32(mode))
+}
+
+func (glBackend) ReadPixels(x, y, width, height int32, format, xtype GLEnum, pixels unsafe.Pointer) {
+	gl.ReadPixels(x, y, width, height, uint32(format), uint32(xtype), pixels)
+}
+
+func (glBackend) AttachShader(program Program, shader Shader) {
+	gl.AttachShader(uint32(program), uint32(shader))
+}
+
+func (glBackend) BindAttribLocation(program Program, index uint32, name string) {
+	gl.BindAttribLocation(uint32(program), index, gl.Str(name+"\x00"))
+}
+
+func (glBackend) CompileShader(shader Shader) {
+	gl.CompileShader(uint32(shader))
+}
+
+func (glBackend) CreateProgram() Program {
+	return Program(gl.CreateProgram())
+}
+
+func (glBackend) CreateShader(xtype GLEnum) Shader {
+	return Shader(gl.CreateShader(uint32(xtype)))
+}
+
+func (glBackend) DeleteProgram(program Program) {
+	gl.DeleteProgram(uint32(program))
+}
+
+func (glBackend) DeleteShader(shader Shader) {
+	gl.DeleteShader(uint32(shader))
+}
+
+func (glBackend) DetachShader(program Program, shader Shader) {
+	gl.DetachShader(uint32(program), uint32(shader))
+}
+
+func (glBackend) GetProgrami(program Program, pname GLEnum) int32 {
+	var params int32
+	gl.GetProgramiv(uint32(program), uint32(pname), &params)
+	return params
+}
+
+func (glBackend) GetProgramInfoLog(program Program) string {
+	var bufSize int32
+	gl.GetProgramiv(uint32(program), gl.INFO_LOG_LENGTH, &bufSize)
+	if bufSize <= 0 {
+		return ""
+	}
+	infoLog := make([]uint8, bufSize)
+	var length int32
+	gl.GetProgramInfoLog(uint32(program), bufSize, &length, &infoLog[0])
+	return string(infoLog[:length])
+}
+
+func (glBackend) GetShaderi(shader Shader, pname GLEnum) int32 {
+	var params int32
+	gl.GetShaderiv(uint32(shader), uint32(pname), &params)
+	return params
+}
+
+func (glBackend) GetShaderInfoLog(shader Shader) string {
+	var bufSize int32
+	gl.GetShaderiv(uint32(shader), gl.INFO_LOG_LENGTH, &bufSize)
+	if bufSize <= 0 {
+		return ""
+	}
+	infoLog := make([]uint8, bufSize)
+	var length int32
+	gl.GetShaderInfoLog(uint32(shader), bufSize, &length, &infoLog[0])
+	return string(infoLog[:length])
+}
+
+func (glBackend) IsProgram(program Program) bool {
+	return gl.IsProgram(uint32(program))
+}
+
+func (glBackend) IsShader(shader Shader) bool {
+	return gl.IsShader(uint32(shader))
+}
+
+func (glBackend) LinkProgram(program Program) {
+	gl.LinkProgram(uint32(program))
+}
+
+func (glBackend) ShaderSource(shader Shader, source string) {
+	cstrs, free := gl.Strs(source + "\x00")
+	gl.ShaderSource(uint32(shader), 1, cstrs, nil)
+	free()
+}
+
+func (glBackend) UseProgram(program Program) {
+	gl.UseProgram(uint32(program))
+}
+
+func (glBackend) ValidateProgram(program Program) {
+	gl.ValidateProgram(uint32(program))
+}
+
+func (glBackend) BindRenderbuffer(target GLEnum, renderbuffer Renderbuffer) {
+	gl.BindRenderbuffer(uint32(target), uint32(renderbuffer))
+}
+
+func (glBackend) CreateRenderbuffer() Renderbuffer {
+	var renderbuffer uint32
+	gl.GenRenderbuffers(1, &renderbuffer)
+	return Renderbuffer(renderbuffer)
+}
+
+func (glBackend) DeleteRenderbuffer(renderbuffer Renderbuffer) {
+	renderbuffers := uint32(renderbuffer)
+	gl.DeleteRenderbuffers(1, &renderbuffers)
+}
+
+func (glBackend) GetRenderbufferParameteri(target, pname GLEnum) int32 {
+	var params int32
+	gl.GetRenderbufferParameteriv(uint32(target), uint32(pname), &params)
+	return params
+}
+
+func (glBackend) IsRenderbuffer(renderbuffer Renderbuffer) bool {
+	return gl.IsRenderbuffer(uint32(renderbuffer))
+}
+
+func (glBackend) RenderbufferStorage(target, internalFormat GLEnum, width, height int32) {
+	gl.RenderbufferStorage(uint32(target), uint32(internalFormat), width, height)
+}
+
+func (glBackend) RenderbufferStorageMultisample(target GLEnum, samples int32, internalFormat GLEnum, width, height int32) {
+	gl.RenderbufferStorageMultisample(uint32(target), samples, uint32(internalFormat), width, height)
+}
+
+func (glBackend) BindTexture(target GLEnum, texture Texture) {
+	gl.BindTexture(uint32(target), uint32(texture))
+}
+
+func (glBackend) CompressedTexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border, imageSize int32, pixels unsafe.Pointer) {
+	gl.CompressedTexImage2D(uint32(target), level, uint32(internalformat), width, height, border, imageSize, pixels)
+}
+
+func (glBackend) CompressedTexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format GLEnum, imageSize int32, pixels unsafe.Pointer) {
+	gl.CompressedTexSubImage2D(uint32(target), level, xoffset, yoffset, width, height, uint32(format), imageSize, pixels)
+}
+
+func (glBackend) CopyTexImage2D(target GLEnum, level int32, internalformat GLEnum, x, y, width, height, border int32) {
+	gl.CopyTexImage2D(uint32(target), level, uint32(internalformat), x, y, width, height, border)
+}
+
+func (glBackend) CopyTexSubImage2D(target GLEnum, level, xoffset, yoffset, x, y, width, height int32) {
+	gl.CopyTexSubImage2D(uint32(target), level, xoffset, yoffset, x, y, width, height)
+}
+
+func (glBackend) CreateTexture() Texture {
+	var texture uint32
+	gl.GenTextures(1, &texture)
+	return Texture(texture)
+}
+
+func (glBackend) DeleteTexture(texture Texture) {
+	textures := uint32(texture)
+	gl.DeleteTextures(1, &textures)
+}
+
+func (glBackend) GenerateMipmap(target GLEnum) {
+	gl.GenerateMipmap(uint32(target))
+}
+
+func (glBackend) IsTexture(texture Texture) bool {
+	return gl.IsTexture(uint32(texture))
+}
+
+func (glBackend) TexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border int32, format, xtype GLEnum, pixels unsafe.Pointer) {
+	gl.TexImage2D(uint32(target), level, int32(internalformat), width, height, border, uint32(format), uint32(xtype), pixels)
+}
+
+func (glBackend) TexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format, xtype GLEnum, pixels unsafe.Pointer) {
+	gl.TexSubImage2D(uint32(target), level, xoffset, yoffset, width, height, uint32(format), uint32(xtype), pixels)
+}
+
+func (glBackend) TexParameterf(target, pname GLEnum, param float32) {
+	gl.TexParameterf(uint32(target), uint32(pname), param)
+}
+
+func (glBackend) TexParameteri(target, pname GLEnum, param int32) {
+	gl.TexParameteri(uint32(target), uint32(pname), param)
+}
+
+func (glBackend) DisableVertexAttribArray(index uint32) {
+	gl.DisableVertexAttribArray(index)
+}
+
+func (glBackend) EnableVertexAttribArray(index uint32) {
+	gl.EnableVertexAttribArray(index)
+}
+
+func (glBackend) GetActiveAttrib(program Program, index uint32) (string, int32, GLEnum) {
+	var bufSize int32
+	gl.GetProgramiv(uint32(program), gl.ACTIVE_ATTRIBUTE_MAX_LENGTH, &bufSize)
+	name := make([]uint8, bufSize+1)
+	var length, size int32
+	var xtype uint32
+	gl.GetActiveAttrib(uint32(program), index, bufSize+1, &length, &size, &xtype, &name[0])
+	return string(name[:length]), size, GLEnum(xtype)
+}
+
+func (glBackend) GetActiveUniform(program Program, index uint32) (string, int32, GLEnum) {
+	var bufSize int32
+	gl.GetProgramiv(uint32(program), gl.ACTIVE_UNIFORM_MAX_LENGTH, &bufSize)
+	name := make([]uint8, bufSize+1)
+	var length, size int32
+	var xtype uint32
+	gl.GetActiveUniform(uint32(program), index, bufSize+1, &length, &size, &xtype, &name[0])
+	return string(name[:length]), size, GLEnum(xtype)
+}
+
+func (glBackend) GetAttribLocation(program Program, name string) int32 {
+	return gl.GetAttribLocation(uint32(program), gl.Str(name+"\x00"))
+}
+
+func (glBackend) GetUniformLocation(program Program, name string) UniformLocation {
+	return UniformLocation(gl.GetUniformLocation(uint32(program), gl.Str(name+"\x00")))
+}
+
+func (glBackend) Uniform1f(location UniformLocation, v0 float32) {
+	gl.Uniform1f(int32(location), v0)
+}
+
+func (glBackend) Uniform1fv(location UniformLocation, count int32, value []float32) {
+	gl.Uniform1fv(int32(location), count, &value[0])
+}
+
+func (glBackend) Uniform1i(location UniformLocation, v0 int32) {
+	gl.Uniform1i(int32(location), v0)
+}
+
+func (glBackend) Uniform1iv(location UniformLocation, count int32, value []int32) {
+	gl.Uniform1iv(int32(location), count, &value[0])
+}
+
+func (glBackend) Uniform2f(location UniformLocation, v0, v1 float32) {
+	gl.Uniform2f(int32(location), v0, v1)
+}
+
+func (glBackend) Uniform2fv(location UniformLocation, count int32, value []float32) {
+	gl.Uniform2fv(int32(location), count, &value[0])
+}
+
+func (glBackend) Uniform2i(location UniformLocation, v0, v1 int32) {
+	gl.Uniform2i(int32(location), v0, v1)
+}
+
+func (glBackend) Uniform2iv(location UniformLocation, count int32, value []int32) {
+	gl.Uniform2iv(int32(location), count, &value[0])
+}
+
+func (glBackend) Uniform3f(location UniformLocation, v0, v1, v2 float32) {
+	gl.Uniform3f(int32(location), v0, v1, v2)
+}
+
+func (glBackend) Uniform3fv(location UniformLocation, count int32, value []float32) {
+	gl.Uniform3fv(int32(location), count, &value[0])
+}
+
+func (glBackend) Uniform3i(location UniformLocation, v0, v1, v2 int32) {
+	gl.Uniform3i(int32(location), v0, v1, v2)
+}
+
+func (glBackend) Uniform3iv(location UniformLocation, count int32, value []int32) {
+	gl.Uniform3iv(int32(location), count, &value[0])
+}
+
+func (glBackend) Uniform4f(location UniformLocation, v0, v1, v2, v3 float32) {
+	gl.Uniform4f(int32(location), v0, v1, v2, v3)
+}
+
+func (glBackend) Uniform4fv(location UniformLocation, count int32, value []float32) {
+	gl.Uniform4fv(int32(location), count, &value[0])
+}
+
+func (glBackend) Uniform4i(location UniformLocation, v0, v1, v2, v3 int32) {
+	gl.Uniform4i(int32(location), v0, v1, v2, v3)
+}
+
+func (glBackend) Uniform4iv(location UniformLocation, count int32, value []int32) {
+	gl.Uniform4iv(int32(location), count, &value[0])
+}
+
+func (glBackend) UniformMatrix2fv(location UniformLocation, count int32, transpose bool, value []float32) {
+	gl.UniformMatrix2fv(int32(location), count, transpose, &value[0])
+}
+
+func (glBackend) UniformMatrix3fv(location UniformLocation, count int32, transpose bool, value []float32) {
+	gl.UniformMatrix3fv(int32(location), count, transpose, &value[0])
+}
+
+func (glBackend) UniformMatrix4fv(location UniformLocation, count int32, transpose bool, value []float32) {
+	gl.UniformMatrix4fv(int32(location), count, transpose, &value[0])
+}
+
+func (glBackend) VertexAttrib1f(index uint32, v0 float32) {
+	gl.VertexAttrib1f(index, v0)
+}
+
+func (glBackend) VertexAttrib2f(index uint32, v0, v1 float32) {
+	gl.VertexAttrib2f(index, v0, v1)
+}
+
+func (glBackend) VertexAttrib3f(index uint32, v0, v1, v2 float32) {
+	gl.VertexAttrib3f(index, v0, v1, v2)
+}
+
+func (glBackend) VertexAttrib4f(index uint32, v0, v1, v2, v3 float32) {
+	gl.VertexAttrib4f(index, v0, v1, v2, v3)
+}
+
+func (glBackend) VertexAttrib1fv(index uint32, value []float32) {
+	gl.VertexAttrib1fv(index, &value[0])
+}
+
+func (glBackend) VertexAttrib2fv(index uint32, value []float32) {
+	gl.VertexAttrib2fv(index, &value[0])
+}
+
+func (glBackend) VertexAttrib3fv(index uint32, value []float32) {
+	gl.VertexAttrib3fv(index, &value[0])
+}
+
+func (glBackend) VertexAttrib4fv(index uint32, value []float32) {
+	gl.VertexAttrib4fv(index, &value[0])
+}
+
+func (glBackend) VertexAttribDivisor(index, divisor uint32) {
+	gl.VertexAttribDivisorARB(index, divisor)
+}
+
+func (glBackend) VertexAttribPointer(index uint32, size int32, xtype GLEnum, normalized bool, stride int32, offset int) {
+	gl.VertexAttribPointer(index, size, uint32(xtype), normalized, stride, gl.PtrOffset(offset))
+}
+
+func (glBackend) BindVertexArray(array VertexArray) {
+	gl.BindVertexArray(uint32(array))
+}
+
+func (glBackend) CreateVertexArray() VertexArray {
+	var array uint32
+	gl.GenVertexArrays(1, &array)
+	return VertexArray(array)
+}
+
+func (glBackend) DeleteVertexArray(array VertexArray) {
+	arrays := uint32(array)
+	gl.DeleteVertexArrays(1, &arrays)
+}
+
+func (glBackend) IsVertexArray(array VertexArray) bool {
+	return gl.IsVertexArray(uint32(array))
+}
+
+func (glBackend) Scissor(x, y, width, height int32) {
+	gl.Scissor(x, y, width, height)
+}
+
+func (glBackend) Viewport(x, y, width, height int32) {
+	gl.Viewport(x, y, width, height)
+}
@@ -1,5 +1,5 @@
 module github.com/pegasus-toolset/gogl
 
-go 1.14
+go 1.18
 
 require github.com/go-gl/gl v0.0.0-20190320180904-bf2b1f2f34d7
@@ -3,8 +3,6 @@
 // programming language.
 package gogl
 
-import "github.com/go-gl/gl/v2.1/gl"
-
 // Init intializes the OpenGL bindings by loading the function pointers (for
 // each OpenGL function) from the active OpenGL context.
 //
@@ -25,11 +23,39 @@ import "github.com/go-gl/gl/v2.1/gl"
 // For information about caveats of Init, you should read the "Platform Specific
 // Function Retrieval" section of
 // https://www.opengl.org/wiki/Load_OpenGL_Functions.
+//
+// Init uses the Backend returned by NewGLBackend. To use a different Backend,
+// call InitWithBackend instead.
 func Init() error {
-	return gl.Init()
+	return InitWithBackend(NewGLBackend())
+}
+
+// InitWithBackend makes b the Backend all functions of this package forward to
+// and initializes it. The previous Backend stays in use if b fails to
+// initialize. The uniform locations and emulated vertex arrays of the previous
+// Backend are dropped, and the version, extensions and unpack alignment of the
+// context of b are queried, e.g., for InstancingSupported. Afterwards, the
+// unpack alignment must only be changed with PixelStorei.
+//
+// The same caveats as for Init apply, though a Backend that does not talk to a
+// driver, e.g., a fake used in unit tests, may not need an active OpenGL
+// context at all.
+func InitWithBackend(b Backend) error {
+	if err := b.Init(); err != nil {
+		return err
+	}
+	backend = b
+	uniformLocations = map[Program]map[string]UniformLocation{}
+	currentProgram = 0
+	capabilities = queryCapabilities()
+	unpackAlignment = GetUnpackAlignment()
+	emulatedVertexArrays = nil
+	boundEmulatedVertexArray = nil
+	defaultEmulatedVertexArray = nil
+	return nil
 }
 
 // GetString returns a string describing the current GL connection.
 func GetString(name GLEnum) string {
-	return gl.GoStr(gl.GetString(uint32(name)))
+	return backend.GetString(name)
 }
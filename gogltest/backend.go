@@ -0,0 +1,1441 @@
+// Package gogltest provides a fake gogl.Backend for testing rendering code
+// without an OpenGL context or a GPU.
+package gogltest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+// Call is a single recorded call to a Backend.
+type Call struct {
+	// Name is the name of the called Backend method, e.g., "DrawArrays".
+	Name string
+	// Args are the arguments of the call, using the types of the Backend
+	// method. Slices are copied and pointers to payloads are left out.
+	Args []interface{}
+	// Size is the size of the payload in bytes, if the call has one.
+	Size int
+	// Data is a copy of the payload, if the call has one and it is not nil.
+	Data []byte
+}
+
+// String returns the call formatted as Go code, e.g.,
+// "DrawArrays(4, 0, 36)".
+func (call Call) String() string {
+	args := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		args[i] = fmt.Sprint(arg)
+	}
+	return call.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// object identifies an object by its kind and its name.
+type object struct {
+	kind string
+	name uint32
+}
+
+// textureBinding identifies a texture binding point of a texture unit.
+type textureBinding struct {
+	unit, target gogl.GLEnum
+}
+
+// Backend is a gogl.Backend that records every call instead of talking to a
+// driver. It hands out object names, and tracks which objects are bound,
+// enabled or deleted, so that tests can assert on both, the sequence of calls
+// and the resulting state.
+//
+// Backend is not safe for concurrent use, just like an OpenGL context.
+type Backend struct {
+	// Calls lists every call in the order it was made.
+	Calls []Call
+
+	// Errors is the queue of errors returned by GetError. GetError returns
+	// gogl.GLNoError when it is empty.
+	Errors []gogl.GLEnum
+	// Strings holds the values returned by GetString. GLVersion and
+	// GLExtensions are queried by gogl.InitWithBackend, so they need to be set
+	// before it is called.
+	Strings map[gogl.GLEnum]string
+	// Integers, Floats and Booleans hold the values returned by GetIntegerv,
+	// GetFloatv and GetBooleanv for parameters the Backend does not track
+	// itself. GLMaxDrawBuffers and GLMaxColorAttachments default to 8, and
+	// GLMaxSamples, GLPackAlignment and GLUnpackAlignment to 4.
+	Integers map[gogl.GLEnum][]int32
+	Floats   map[gogl.GLEnum][]float32
+	Booleans map[gogl.GLEnum][]bool
+	// FramebufferStatus is returned by CheckFramebufferStatus. It defaults to
+	// gogl.GLFramebufferComplete.
+	FramebufferStatus gogl.GLEnum
+	// ReadPixelsFunc, if not nil, fills the pixels read by ReadPixels, which
+	// are laid out according to the format, type and GLPackAlignment.
+	ReadPixelsFunc func(x, y, width, height int32, format, xtype gogl.GLEnum, pixels []byte)
+	// CompileShaderFunc, if not nil, decides whether the compilation of a
+	// shader succeeds and which info log it produces. By default, every shader
+	// compiles without a log.
+	CompileShaderFunc func(xtype gogl.GLEnum, source string) (ok bool, infoLog string)
+	// LinkProgramFunc, if not nil, decides whether linking a program succeeds
+	// and which info log it produces. By default, every program links without
+	// a log.
+	LinkProgramFunc func(program gogl.Program, shaders []gogl.Shader) (ok bool, infoLog string)
+
+	names              map[string]uint32
+	live               map[object]bool
+	deleted            map[object]bool
+	bindings           map[gogl.GLEnum]uint32
+	textures           map[textureBinding]gogl.Texture
+	enabled            map[gogl.GLEnum]bool
+	shaders            map[gogl.Shader]*shaderState
+	programs           map[gogl.Program]*programState
+	uniforms           map[gogl.Program]map[string]gogl.UniformLocation
+	attribs            map[gogl.Program]map[string]int32
+	vertexAttribArrays map[uint32]bool
+	attribPointers     map[uint32]AttribPointer
+	vertexArrays       map[gogl.VertexArray]*vertexArrayState
+	attachments        map[gogl.Framebuffer]map[gogl.GLEnum]gogl.FramebufferAttachment
+
+	activeTexture  gogl.GLEnum
+	currentProgram gogl.Program
+}
+
+// shaderState is the state of a shader object.
+type shaderState struct {
+	xtype    gogl.GLEnum
+	source   string
+	compiled bool
+	infoLog  string
+}
+
+// programState is the state of a program object.
+type programState struct {
+	shaders   []gogl.Shader
+	linked    bool
+	validated bool
+	infoLog   string
+
+	// attributes and uniforms are the active variables declared by the test.
+	// They are nil if the test did not declare any.
+	attributes []gogl.ActiveAttribute
+	uniforms   []gogl.ActiveUniform
+}
+
+var _ gogl.Backend = (*Backend)(nil)
+
+// NewBackend returns a Backend with no recorded calls, no objects and the
+// initial state of an OpenGL context.
+func NewBackend() *Backend {
+	b := &Backend{}
+	b.Reset()
+	return b
+}
+
+// Reset discards all recorded calls and state, and returns the Backend to the
+// state NewBackend returns it in. The hooks and values set by the test are
+// reset, too.
+func (b *Backend) Reset() {
+	*b = Backend{
+		Strings: make(map[gogl.GLEnum]string),
+		Integers: map[gogl.GLEnum][]int32{
+			gogl.GLMaxDrawBuffers:      {8},
+			gogl.GLMaxColorAttachments: {8},
+			gogl.GLMaxSamples:          {4},
+			gogl.GLPackAlignment:       {4},
+			gogl.GLUnpackAlignment:     {4},
+		},
+		Floats:            make(map[gogl.GLEnum][]float32),
+		Booleans:          make(map[gogl.GLEnum][]bool),
+		FramebufferStatus: gogl.GLFramebufferComplete,
+
+		names:              make(map[string]uint32),
+		live:               make(map[object]bool),
+		deleted:            make(map[object]bool),
+		bindings:           make(map[gogl.GLEnum]uint32),
+		textures:           make(map[textureBinding]gogl.Texture),
+		enabled:            map[gogl.GLEnum]bool{gogl.GLDither: true},
+		shaders:            make(map[gogl.Shader]*shaderState),
+		programs:           make(map[gogl.Program]*programState),
+		uniforms:           make(map[gogl.Program]map[string]gogl.UniformLocation),
+		attribs:            make(map[gogl.Program]map[string]int32),
+		vertexAttribArrays: make(map[uint32]bool),
+		attribPointers:     make(map[uint32]AttribPointer),
+		vertexArrays:       make(map[gogl.VertexArray]*vertexArrayState),
+		attachments:        make(map[gogl.Framebuffer]map[gogl.GLEnum]gogl.FramebufferAttachment),
+
+		activeTexture: gogl.GLTexture0,
+	}
+}
+
+// ClearCalls discards the recorded calls but keeps all state, e.g., to only
+// assert on the calls of a single render pass after setting up its resources.
+func (b *Backend) ClearCalls() {
+	b.Calls = nil
+}
+
+// CallsTo returns the recorded calls of the Backend method with the given
+// name.
+func (b *Backend) CallsTo(name string) []Call {
+	var calls []Call
+	for _, call := range b.Calls {
+		if call.Name == name {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Binding returns the name of the buffer, framebuffer or renderbuffer bound to
+// the target, or 0 if none is bound.
+func (b *Backend) Binding(target gogl.GLEnum) uint32 {
+	return b.bindings[target]
+}
+
+// BoundTexture returns the Texture bound to the target of the texture unit,
+// e.g., gogl.GLTexture1 and gogl.GLTexture2D.
+func (b *Backend) BoundTexture(unit, target gogl.GLEnum) gogl.Texture {
+	return b.textures[textureBinding{unit, target}]
+}
+
+// ActiveTextureUnit returns the active texture unit.
+func (b *Backend) ActiveTextureUnit() gogl.GLEnum {
+	return b.activeTexture
+}
+
+// CurrentProgram returns the Program in use.
+func (b *Backend) CurrentProgram() gogl.Program {
+	return b.currentProgram
+}
+
+// Enabled reports whether a capability is enabled without recording a call.
+func (b *Backend) Enabled(cap gogl.GLEnum) bool {
+	return b.enabled[cap]
+}
+
+// VertexAttribArrayEnabled reports whether the generic vertex attribute array
+// at index is enabled.
+func (b *Backend) VertexAttribArrayEnabled(index uint32) bool {
+	return b.vertexAttribArrays[index]
+}
+
+// AttribPointer is the vertex attribute array state set by
+// VertexAttribPointer and VertexAttribDivisor.
+type AttribPointer struct {
+	// Buffer is the Buffer that was bound to gogl.GLArrayBuffer.
+	Buffer     gogl.Buffer
+	Size       int32
+	Type       gogl.GLEnum
+	Normalized bool
+	Stride     int32
+	Offset     int
+	// Divisor is set by VertexAttribDivisor.
+	Divisor uint32
+}
+
+// AttribPointer returns the state set by the last calls to
+// VertexAttribPointer and VertexAttribDivisor for index, and whether there
+// was such a call.
+func (b *Backend) AttribPointer(index uint32) (AttribPointer, bool) {
+	pointer, ok := b.attribPointers[index]
+	return pointer, ok
+}
+
+// Attachment returns what FramebufferTexture2D or FramebufferRenderbuffer
+// attached to the attachment point of the Framebuffer. Its ObjectType is
+// gogl.GLNone if nothing is attached.
+func (b *Backend) Attachment(framebuffer gogl.Framebuffer, attachment gogl.GLEnum) gogl.FramebufferAttachment {
+	if info, ok := b.attachments[framebuffer][attachment]; ok {
+		return info
+	}
+	return gogl.FramebufferAttachment{Attachment: attachment, ObjectType: gogl.GLNone}
+}
+
+// Deleted reports whether the object of the given kind has been deleted. The
+// kind is one of "buffer", "framebuffer", "program", "renderbuffer", "shader",
+// "texture" or "vertex array".
+func (b *Backend) Deleted(kind string, name uint32) bool {
+	return b.deleted[objectKey(kind, name)]
+}
+
+// Source returns the source code set for the Shader.
+func (b *Backend) Source(shader gogl.Shader) string {
+	if s, ok := b.shaders[shader]; ok {
+		return s.source
+	}
+	return ""
+}
+
+// SetActiveAttributes declares the active attributes of the Program, as if
+// linking it had found them in its shaders. Afterwards, GetAttribLocation
+// returns their locations, and -1 for every other name.
+func (b *Backend) SetActiveAttributes(program gogl.Program, attributes ...gogl.ActiveAttribute) {
+	if p, ok := b.programs[program]; ok {
+		p.attributes = append([]gogl.ActiveAttribute{}, attributes...)
+	}
+}
+
+// SetActiveUniforms declares the active uniforms of the Program, as if linking
+// it had found them in its shaders. Afterwards, GetUniformLocation returns
+// their locations, including those of the elements of arrays, and -1 for
+// every other name.
+func (b *Backend) SetActiveUniforms(program gogl.Program, uniforms ...gogl.ActiveUniform) {
+	if p, ok := b.programs[program]; ok {
+		p.uniforms = append([]gogl.ActiveUniform{}, uniforms...)
+	}
+}
+
+// vertexArrayState is the state of a vertex array object that is not bound.
+// The state of the bound one is held by the Backend itself.
+type vertexArrayState struct {
+	vertexAttribArrays map[uint32]bool
+	attribPointers     map[uint32]AttribPointer
+	elementArrayBuffer uint32
+}
+
+// objectKey returns the key of an object. Programs and shaders share their
+// names like they do in OpenGL.
+func objectKey(kind string, name uint32) object {
+	if kind == "shader" {
+		kind = "program"
+	}
+	return object{kind, name}
+}
+
+func (b *Backend) record(name string, args ...interface{}) {
+	b.Calls = append(b.Calls, Call{Name: name, Args: args})
+}
+
+func (b *Backend) recordPayload(size int, data unsafe.Pointer, name string, args ...interface{}) {
+	call := Call{Name: name, Args: args, Size: size}
+	if data != nil && size > 0 {
+		call.Data = make([]byte, size)
+		copy(call.Data, unsafe.Slice((*byte)(data), size))
+	}
+	b.Calls = append(b.Calls, call)
+}
+
+func (b *Backend) create(kind string) uint32 {
+	key := objectKey(kind, 0).kind
+	b.names[key]++
+	name := b.names[key]
+	b.live[objectKey(kind, name)] = true
+	return name
+}
+
+func (b *Backend) delete(kind string, name uint32) {
+	key := objectKey(kind, name)
+	if name == 0 || !b.live[key] {
+		return
+	}
+	delete(b.live, key)
+	b.deleted[key] = true
+}
+
+func (b *Backend) isLive(kind string, name uint32) bool {
+	return b.live[objectKey(kind, name)]
+}
+
+// alignment returns the row alignment set for GLPackAlignment or
+// GLUnpackAlignment.
+func (b *Backend) alignment(pname gogl.GLEnum) int32 {
+	if value := b.Integers[pname]; len(value) > 0 && value[0] > 0 {
+		return value[0]
+	}
+	return 1
+}
+
+// unbind resets every binding of the named object to 0, like OpenGL does when
+// deleting a bound object.
+func (b *Backend) unbind(targets []gogl.GLEnum, name uint32) {
+	for _, target := range targets {
+		if b.bindings[target] == name {
+			delete(b.bindings, target)
+		}
+	}
+}
+
+var (
+	bufferTargets       = []gogl.GLEnum{gogl.GLArrayBuffer, gogl.GLElementArrayBuffer}
+	framebufferTargets  = []gogl.GLEnum{gogl.GLFramebuffer, gogl.GLReadFramebuffer, gogl.GLDrawFramebuffer}
+	renderbufferTargets = []gogl.GLEnum{gogl.GLRenderbuffer}
+)
+
+// Init implements gogl.Backend.
+func (b *Backend) Init() error {
+	b.record("Init")
+	return nil
+}
+
+// GetString implements gogl.Backend.
+func (b *Backend) GetString(name gogl.GLEnum) string {
+	b.record("GetString", name)
+	return b.Strings[name]
+}
+
+// GetError implements gogl.Backend.
+func (b *Backend) GetError() gogl.GLEnum {
+	b.record("GetError")
+	if len(b.Errors) == 0 {
+		return gogl.GLNoError
+	}
+	err := b.Errors[0]
+	b.Errors = b.Errors[1:]
+	return err
+}
+
+// BindBuffer implements gogl.Backend.
+func (b *Backend) BindBuffer(target gogl.GLEnum, buffer gogl.Buffer) {
+	b.record("BindBuffer", target, buffer)
+	b.bindings[target] = uint32(buffer)
+}
+
+// BufferData implements gogl.Backend.
+func (b *Backend) BufferData(target gogl.GLEnum, size int, data unsafe.Pointer, usage gogl.GLEnum) {
+	b.recordPayload(size, data, "BufferData", target, size, usage)
+}
+
+// BufferSubData implements gogl.Backend.
+func (b *Backend) BufferSubData(target gogl.GLEnum, offset, size int, data unsafe.Pointer) {
+	b.recordPayload(size, data, "BufferSubData", target, offset, size)
+}
+
+// CreateBuffer implements gogl.Backend.
+func (b *Backend) CreateBuffer() gogl.Buffer {
+	buffer := gogl.Buffer(b.create("buffer"))
+	b.record("CreateBuffer")
+	return buffer
+}
+
+// DeleteBuffer implements gogl.Backend.
+func (b *Backend) DeleteBuffer(buffer gogl.Buffer) {
+	b.record("DeleteBuffer", buffer)
+	if b.isLive("buffer", uint32(buffer)) {
+		b.unbind(bufferTargets, uint32(buffer))
+	}
+	b.delete("buffer", uint32(buffer))
+}
+
+// GetBufferParameteri implements gogl.Backend.
+func (b *Backend) GetBufferParameteri(target, pname gogl.GLEnum) int32 {
+	b.record("GetBufferParameteri", target, pname)
+	return 0
+}
+
+// IsBuffer implements gogl.Backend.
+func (b *Backend) IsBuffer(buffer gogl.Buffer) bool {
+	b.record("IsBuffer", buffer)
+	return b.isLive("buffer", uint32(buffer))
+}
+
+// ActiveTexture implements gogl.Backend.
+func (b *Backend) ActiveTexture(texture gogl.GLEnum) {
+	b.record("ActiveTexture", texture)
+	b.activeTexture = texture
+}
+
+// BlendColor implements gogl.Backend.
+func (b *Backend) BlendColor(red, green, blue, alpha float32) {
+	b.record("BlendColor", red, green, blue, alpha)
+}
+
+// BlendEquation implements gogl.Backend.
+func (b *Backend) BlendEquation(mode gogl.GLEnum) {
+	b.record("BlendEquation", mode)
+}
+
+// BlendEquationSeparate implements gogl.Backend.
+func (b *Backend) BlendEquationSeparate(modeRGB, modeAlpha gogl.GLEnum) {
+	b.record("BlendEquationSeparate", modeRGB, modeAlpha)
+}
+
+// BlendFunc implements gogl.Backend.
+func (b *Backend) BlendFunc(sfactor, dfactor gogl.GLEnum) {
+	b.record("BlendFunc", sfactor, dfactor)
+}
+
+// BlendFuncSeparate implements gogl.Backend.
+func (b *Backend) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha gogl.GLEnum) {
+	b.record("BlendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+// ClearColor implements gogl.Backend.
+func (b *Backend) ClearColor(red, green, blue, alpha float32) {
+	b.record("ClearColor", red, green, blue, alpha)
+}
+
+// ClearDepth implements gogl.Backend.
+func (b *Backend) ClearDepth(depth float32) {
+	b.record("ClearDepth", depth)
+}
+
+// ClearStencil implements gogl.Backend.
+func (b *Backend) ClearStencil(s int32) {
+	b.record("ClearStencil", s)
+}
+
+// ColorMask implements gogl.Backend.
+func (b *Backend) ColorMask(red, green, blue, alpha bool) {
+	b.record("ColorMask", red, green, blue, alpha)
+}
+
+// CullFace implements gogl.Backend.
+func (b *Backend) CullFace(mode gogl.GLEnum) {
+	b.record("CullFace", mode)
+}
+
+// DepthFunc implements gogl.Backend.
+func (b *Backend) DepthFunc(xfunc gogl.GLEnum) {
+	b.record("DepthFunc", xfunc)
+}
+
+// DepthMask implements gogl.Backend.
+func (b *Backend) DepthMask(flag bool) {
+	b.record("DepthMask", flag)
+}
+
+// DepthRange implements gogl.Backend.
+func (b *Backend) DepthRange(zNear, zFar float32) {
+	b.record("DepthRange", zNear, zFar)
+}
+
+// Disable implements gogl.Backend.
+func (b *Backend) Disable(cap gogl.GLEnum) {
+	b.record("Disable", cap)
+	delete(b.enabled, cap)
+}
+
+// Enable implements gogl.Backend.
+func (b *Backend) Enable(cap gogl.GLEnum) {
+	b.record("Enable", cap)
+	b.enabled[cap] = true
+}
+
+// FrontFace implements gogl.Backend.
+func (b *Backend) FrontFace(mode gogl.GLEnum) {
+	b.record("FrontFace", mode)
+}
+
+// GetBooleanv implements gogl.Backend. Capabilities report whether they are
+// enabled, everything else is read from Booleans.
+func (b *Backend) GetBooleanv(pname gogl.GLEnum, data []bool) {
+	b.record("GetBooleanv", pname)
+	if values, ok := b.Booleans[pname]; ok {
+		copy(data, values)
+		return
+	}
+	data[0] = b.enabled[pname]
+}
+
+// GetFloatv implements gogl.Backend. The values are read from Floats.
+func (b *Backend) GetFloatv(pname gogl.GLEnum, data []float32) {
+	b.record("GetFloatv", pname)
+	copy(data, b.Floats[pname])
+}
+
+// GetIntegerv implements gogl.Backend. Bindings, the active texture unit and
+// the current program are tracked by the Backend, everything else is read from
+// Integers.
+func (b *Backend) GetIntegerv(pname gogl.GLEnum, data []int32) {
+	b.record("GetIntegerv", pname)
+	switch pname {
+	case gogl.GLArrayBufferBinding:
+		data[0] = int32(b.bindings[gogl.GLArrayBuffer])
+	case gogl.GLElementArrayBufferBinding:
+		data[0] = int32(b.bindings[gogl.GLElementArrayBuffer])
+	case gogl.GLFramebufferBinding:
+		data[0] = int32(b.bindings[gogl.GLFramebuffer])
+	case gogl.GLReadFramebufferBinding:
+		data[0] = int32(b.bindings[gogl.GLReadFramebuffer])
+	case gogl.GLRenderbufferBinding:
+		data[0] = int32(b.bindings[gogl.GLRenderbuffer])
+	case gogl.GLTextureBinding2D:
+		data[0] = int32(b.textures[textureBinding{b.activeTexture, gogl.GLTexture2D}])
+	case gogl.GLTextureBindingCubeMap:
+		data[0] = int32(b.textures[textureBinding{b.activeTexture, gogl.GLTextureCubeMap}])
+	case gogl.GLActiveTexture:
+		data[0] = int32(b.activeTexture)
+	case gogl.GLCurrentProgram:
+		data[0] = int32(b.currentProgram)
+	case gogl.GLVertexArrayBinding:
+		data[0] = int32(b.bindings[gogl.GLVertexArrayBinding])
+	default:
+		copy(data, b.Integers[pname])
+	}
+}
+
+// Hint implements gogl.Backend.
+func (b *Backend) Hint(target, mode gogl.GLEnum) {
+	b.record("Hint", target, mode)
+}
+
+// IsEnabled implements gogl.Backend.
+func (b *Backend) IsEnabled(cap gogl.GLEnum) bool {
+	b.record("IsEnabled", cap)
+	return b.enabled[cap]
+}
+
+// LineWidth implements gogl.Backend.
+func (b *Backend) LineWidth(width float32) {
+	b.record("LineWidth", width)
+}
+
+// PixelStorei implements gogl.Backend.
+func (b *Backend) PixelStorei(pname gogl.GLEnum, param int32) {
+	b.record("PixelStorei", pname, param)
+	b.Integers[pname] = []int32{param}
+}
+
+// PolygonOffset implements gogl.Backend.
+func (b *Backend) PolygonOffset(factor, units float32) {
+	b.record("PolygonOffset", factor, units)
+}
+
+// SampleCoverage implements gogl.Backend.
+func (b *Backend) SampleCoverage(value float32, invert bool) {
+	b.record("SampleCoverage", value, invert)
+}
+
+// StencilFunc implements gogl.Backend.
+func (b *Backend) StencilFunc(xfunc gogl.GLEnum, ref int32, mask uint32) {
+	b.record("StencilFunc", xfunc, ref, mask)
+}
+
+// StencilFuncSeparate implements gogl.Backend.
+func (b *Backend) StencilFuncSeparate(face, xfunc gogl.GLEnum, ref int32, mask uint32) {
+	b.record("StencilFuncSeparate", face, xfunc, ref, mask)
+}
+
+// StencilMask implements gogl.Backend.
+func (b *Backend) StencilMask(mask uint32) {
+	b.record("StencilMask", mask)
+}
+
+// StencilMaskSeparate implements gogl.Backend.
+func (b *Backend) StencilMaskSeparate(face gogl.GLEnum, mask uint32) {
+	b.record("StencilMaskSeparate", face, mask)
+}
+
+// StencilOp implements gogl.Backend.
+func (b *Backend) StencilOp(fail, zfail, zpass gogl.GLEnum) {
+	b.record("StencilOp", fail, zfail, zpass)
+}
+
+// StencilOpSeparate implements gogl.Backend.
+func (b *Backend) StencilOpSeparate(face, fail, zfail, zpass gogl.GLEnum) {
+	b.record("StencilOpSeparate", face, fail, zfail, zpass)
+}
+
+// Clear implements gogl.Backend.
+func (b *Backend) Clear(mask gogl.GLEnum) {
+	b.record("Clear", mask)
+}
+
+// DrawArrays implements gogl.Backend.
+func (b *Backend) DrawArrays(mode gogl.GLEnum, first, count int32) {
+	b.record("DrawArrays", mode, first, count)
+}
+
+// DrawArraysInstanced implements gogl.Backend.
+func (b *Backend) DrawArraysInstanced(mode gogl.GLEnum, first, count, instanceCount int32) {
+	b.record("DrawArraysInstanced", mode, first, count, instanceCount)
+}
+
+// DrawElements implements gogl.Backend.
+func (b *Backend) DrawElements(mode gogl.GLEnum, count int32, xtype gogl.GLEnum, offset int) {
+	b.record("DrawElements", mode, count, xtype, offset)
+}
+
+// DrawElementsInstanced implements gogl.Backend.
+func (b *Backend) DrawElementsInstanced(mode gogl.GLEnum, count int32, xtype gogl.GLEnum, offset int, instanceCount int32) {
+	b.record("DrawElementsInstanced", mode, count, xtype, offset, instanceCount)
+}
+
+// Finish implements gogl.Backend.
+func (b *Backend) Finish() {
+	b.record("Finish")
+}
+
+// Flush implements gogl.Backend.
+func (b *Backend) Flush() {
+	b.record("Flush")
+}
+
+// BindFramebuffer implements gogl.Backend.
+func (b *Backend) BindFramebuffer(target gogl.GLEnum, framebuffer gogl.Framebuffer) {
+	b.record("BindFramebuffer", target, framebuffer)
+	// GLFramebuffer binds both, the read and the draw framebuffer, and
+	// GLFramebufferBinding is the draw framebuffer binding.
+	switch target {
+	case gogl.GLFramebuffer:
+		b.bindings[gogl.GLReadFramebuffer] = uint32(framebuffer)
+		b.bindings[gogl.GLDrawFramebuffer] = uint32(framebuffer)
+	case gogl.GLDrawFramebuffer:
+		b.bindings[gogl.GLFramebuffer] = uint32(framebuffer)
+	}
+	b.bindings[target] = uint32(framebuffer)
+}
+
+// BlitFramebuffer implements gogl.Backend.
+func (b *Backend) BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int32, mask, filter gogl.GLEnum) {
+	b.record("BlitFramebuffer", srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+}
+
+// CheckFramebufferStatus implements gogl.Backend. It returns
+// FramebufferStatus.
+func (b *Backend) CheckFramebufferStatus(target gogl.GLEnum) gogl.GLEnum {
+	b.record("CheckFramebufferStatus", target)
+	return b.FramebufferStatus
+}
+
+// CreateFramebuffer implements gogl.Backend.
+func (b *Backend) CreateFramebuffer() gogl.Framebuffer {
+	framebuffer := gogl.Framebuffer(b.create("framebuffer"))
+	b.record("CreateFramebuffer")
+	return framebuffer
+}
+
+// DeleteFramebuffer implements gogl.Backend.
+func (b *Backend) DeleteFramebuffer(framebuffer gogl.Framebuffer) {
+	b.record("DeleteFramebuffer", framebuffer)
+	if b.isLive("framebuffer", uint32(framebuffer)) {
+		b.unbind(framebufferTargets, uint32(framebuffer))
+	}
+	b.delete("framebuffer", uint32(framebuffer))
+	delete(b.attachments, framebuffer)
+}
+
+// DrawBuffer implements gogl.Backend.
+func (b *Backend) DrawBuffer(mode gogl.GLEnum) {
+	b.record("DrawBuffer", mode)
+}
+
+// DrawBuffers implements gogl.Backend. It records a copy of buffers.
+func (b *Backend) DrawBuffers(buffers []gogl.GLEnum) {
+	b.record("DrawBuffers", append([]gogl.GLEnum(nil), buffers...))
+}
+
+// FramebufferRenderbuffer implements gogl.Backend.
+func (b *Backend) FramebufferRenderbuffer(target, attachment, renderbuffertarget gogl.GLEnum, renderbuffer gogl.Renderbuffer) {
+	b.record("FramebufferRenderbuffer", target, attachment, renderbuffertarget, renderbuffer)
+	info := gogl.FramebufferAttachment{Attachment: attachment, ObjectType: gogl.GLNone}
+	if renderbuffer != 0 {
+		info.ObjectType, info.ObjectName = gogl.GLRenderbuffer, uint32(renderbuffer)
+	}
+	b.attach(target, info)
+}
+
+// FramebufferTexture2D implements gogl.Backend.
+func (b *Backend) FramebufferTexture2D(target, attachment, textarget gogl.GLEnum, texture gogl.Texture, level int32) {
+	b.record("FramebufferTexture2D", target, attachment, textarget, texture, level)
+	info := gogl.FramebufferAttachment{Attachment: attachment, ObjectType: gogl.GLNone}
+	if texture != 0 {
+		info.ObjectType, info.ObjectName, info.TextureLevel = gogl.GLTexture, uint32(texture), level
+		if textarget != gogl.GLTexture2D {
+			info.TextureCubeMapFace = textarget
+		}
+	}
+	b.attach(target, info)
+}
+
+// attach records the attachment of the Framebuffer bound to target.
+func (b *Backend) attach(target gogl.GLEnum, info gogl.FramebufferAttachment) {
+	framebuffer := gogl.Framebuffer(b.bindings[target])
+	if framebuffer == 0 {
+		return
+	}
+	if b.attachments[framebuffer] == nil {
+		b.attachments[framebuffer] = make(map[gogl.GLEnum]gogl.FramebufferAttachment)
+	}
+	if info.ObjectType == gogl.GLNone {
+		delete(b.attachments[framebuffer], info.Attachment)
+		return
+	}
+	b.attachments[framebuffer][info.Attachment] = info
+}
+
+// GetFramebufferAttachmentParameteri implements gogl.Backend. It returns the
+// parameters of what FramebufferTexture2D or FramebufferRenderbuffer attached
+// to the Framebuffer bound to target.
+func (b *Backend) GetFramebufferAttachmentParameteri(target, attachment, pname gogl.GLEnum) int32 {
+	b.record("GetFramebufferAttachmentParameteri", target, attachment, pname)
+	info := b.Attachment(gogl.Framebuffer(b.bindings[target]), attachment)
+	switch pname {
+	case gogl.GLFramebufferAttachmentObjectType:
+		return int32(info.ObjectType)
+	case gogl.GLFramebufferAttachmentObjectName:
+		return int32(info.ObjectName)
+	case gogl.GLFramebufferAttachmentTextureLevel:
+		return info.TextureLevel
+	case gogl.GLFramebufferAttachmentTextureCubeMapFace:
+		return int32(info.TextureCubeMapFace)
+	}
+	return 0
+}
+
+// IsFramebuffer implements gogl.Backend.
+func (b *Backend) IsFramebuffer(framebuffer gogl.Framebuffer) bool {
+	b.record("IsFramebuffer", framebuffer)
+	return b.isLive("framebuffer", uint32(framebuffer))
+}
+
+// ReadBuffer implements gogl.Backend.
+func (b *Backend) ReadBuffer(mode gogl.GLEnum) {
+	b.record("ReadBuffer", mode)
+}
+
+// ReadPixels implements gogl.Backend. The pixels are filled by
+// ReadPixelsFunc, or left untouched if it is nil.
+func (b *Backend) ReadPixels(x, y, width, height int32, format, xtype gogl.GLEnum, pixels unsafe.Pointer) {
+	b.record("ReadPixels", x, y, width, height, format, xtype)
+	if b.ReadPixelsFunc == nil || pixels == nil {
+		return
+	}
+	size := alignedImageSize(width, height, format, xtype, b.alignment(gogl.GLPackAlignment))
+	b.ReadPixelsFunc(x, y, width, height, format, xtype, unsafe.Slice((*byte)(pixels), size))
+}
+
+// AttachShader implements gogl.Backend.
+func (b *Backend) AttachShader(program gogl.Program, shader gogl.Shader) {
+	b.record("AttachShader", program, shader)
+	if p, ok := b.programs[program]; ok {
+		p.shaders = append(p.shaders, shader)
+	}
+}
+
+// BindAttribLocation implements gogl.Backend.
+func (b *Backend) BindAttribLocation(program gogl.Program, index uint32, name string) {
+	b.record("BindAttribLocation", program, index, name)
+}
+
+// CompileShader implements gogl.Backend.
+func (b *Backend) CompileShader(shader gogl.Shader) {
+	b.record("CompileShader", shader)
+	s, ok := b.shaders[shader]
+	if !ok {
+		return
+	}
+	s.compiled, s.infoLog = true, ""
+	if b.CompileShaderFunc != nil {
+		s.compiled, s.infoLog = b.CompileShaderFunc(s.xtype, s.source)
+	}
+}
+
+// CreateProgram implements gogl.Backend.
+func (b *Backend) CreateProgram() gogl.Program {
+	program := gogl.Program(b.create("program"))
+	b.programs[program] = &programState{}
+	b.record("CreateProgram")
+	return program
+}
+
+// CreateShader implements gogl.Backend.
+func (b *Backend) CreateShader(xtype gogl.GLEnum) gogl.Shader {
+	shader := gogl.Shader(b.create("shader"))
+	b.shaders[shader] = &shaderState{xtype: xtype}
+	b.record("CreateShader", xtype)
+	return shader
+}
+
+// DeleteProgram implements gogl.Backend.
+func (b *Backend) DeleteProgram(program gogl.Program) {
+	b.record("DeleteProgram", program)
+	b.delete("program", uint32(program))
+}
+
+// DeleteShader implements gogl.Backend.
+func (b *Backend) DeleteShader(shader gogl.Shader) {
+	b.record("DeleteShader", shader)
+	b.delete("shader", uint32(shader))
+}
+
+// DetachShader implements gogl.Backend.
+func (b *Backend) DetachShader(program gogl.Program, shader gogl.Shader) {
+	b.record("DetachShader", program, shader)
+	p, ok := b.programs[program]
+	if !ok {
+		return
+	}
+	for i, s := range p.shaders {
+		if s == shader {
+			p.shaders = append(p.shaders[:i], p.shaders[i+1:]...)
+			break
+		}
+	}
+}
+
+// GetProgrami implements gogl.Backend.
+func (b *Backend) GetProgrami(program gogl.Program, pname gogl.GLEnum) int32 {
+	b.record("GetProgrami", program, pname)
+	p, ok := b.programs[program]
+	if !ok {
+		return 0
+	}
+	switch pname {
+	case gogl.GLDeleteStatus:
+		return boolToInt32(b.deleted[objectKey("program", uint32(program))])
+	case gogl.GLLinkStatus:
+		return boolToInt32(p.linked)
+	case gogl.GLValidateStatus:
+		return boolToInt32(p.validated)
+	case gogl.GLAttachedShaders:
+		return int32(len(p.shaders))
+	case gogl.GLActiveAttributes:
+		return int32(len(p.attributes))
+	case gogl.GLActiveUniforms:
+		return int32(len(p.uniforms))
+	}
+	return 0
+}
+
+// GetProgramInfoLog implements gogl.Backend.
+func (b *Backend) GetProgramInfoLog(program gogl.Program) string {
+	b.record("GetProgramInfoLog", program)
+	if p, ok := b.programs[program]; ok {
+		return p.infoLog
+	}
+	return ""
+}
+
+// GetShaderi implements gogl.Backend.
+func (b *Backend) GetShaderi(shader gogl.Shader, pname gogl.GLEnum) int32 {
+	b.record("GetShaderi", shader, pname)
+	s, ok := b.shaders[shader]
+	if !ok {
+		return 0
+	}
+	switch pname {
+	case gogl.GLDeleteStatus:
+		return boolToInt32(b.deleted[objectKey("shader", uint32(shader))])
+	case gogl.GLCompileStatus:
+		return boolToInt32(s.compiled)
+	case gogl.GLShaderType:
+		return int32(s.xtype)
+	}
+	return 0
+}
+
+// GetShaderInfoLog implements gogl.Backend.
+func (b *Backend) GetShaderInfoLog(shader gogl.Shader) string {
+	b.record("GetShaderInfoLog", shader)
+	if s, ok := b.shaders[shader]; ok {
+		return s.infoLog
+	}
+	return ""
+}
+
+// IsProgram implements gogl.Backend.
+func (b *Backend) IsProgram(program gogl.Program) bool {
+	b.record("IsProgram", program)
+	_, ok := b.programs[program]
+	return ok && b.isLive("program", uint32(program))
+}
+
+// IsShader implements gogl.Backend.
+func (b *Backend) IsShader(shader gogl.Shader) bool {
+	b.record("IsShader", shader)
+	_, ok := b.shaders[shader]
+	return ok && b.isLive("shader", uint32(shader))
+}
+
+// LinkProgram implements gogl.Backend.
+func (b *Backend) LinkProgram(program gogl.Program) {
+	b.record("LinkProgram", program)
+	p, ok := b.programs[program]
+	if !ok {
+		return
+	}
+	p.linked, p.infoLog = true, ""
+	if b.LinkProgramFunc != nil {
+		p.linked, p.infoLog = b.LinkProgramFunc(program, append([]gogl.Shader(nil), p.shaders...))
+	}
+}
+
+// ShaderSource implements gogl.Backend.
+func (b *Backend) ShaderSource(shader gogl.Shader, source string) {
+	b.record("ShaderSource", shader, source)
+	if s, ok := b.shaders[shader]; ok {
+		s.source = source
+	}
+}
+
+// UseProgram implements gogl.Backend.
+func (b *Backend) UseProgram(program gogl.Program) {
+	b.record("UseProgram", program)
+	b.currentProgram = program
+}
+
+// ValidateProgram implements gogl.Backend.
+func (b *Backend) ValidateProgram(program gogl.Program) {
+	b.record("ValidateProgram", program)
+	if p, ok := b.programs[program]; ok {
+		p.validated = p.linked
+	}
+}
+
+// BindRenderbuffer implements gogl.Backend.
+func (b *Backend) BindRenderbuffer(target gogl.GLEnum, renderbuffer gogl.Renderbuffer) {
+	b.record("BindRenderbuffer", target, renderbuffer)
+	b.bindings[target] = uint32(renderbuffer)
+}
+
+// CreateRenderbuffer implements gogl.Backend.
+func (b *Backend) CreateRenderbuffer() gogl.Renderbuffer {
+	renderbuffer := gogl.Renderbuffer(b.create("renderbuffer"))
+	b.record("CreateRenderbuffer")
+	return renderbuffer
+}
+
+// DeleteRenderbuffer implements gogl.Backend.
+func (b *Backend) DeleteRenderbuffer(renderbuffer gogl.Renderbuffer) {
+	b.record("DeleteRenderbuffer", renderbuffer)
+	if b.isLive("renderbuffer", uint32(renderbuffer)) {
+		b.unbind(renderbufferTargets, uint32(renderbuffer))
+	}
+	b.delete("renderbuffer", uint32(renderbuffer))
+}
+
+// GetRenderbufferParameteri implements gogl.Backend.
+func (b *Backend) GetRenderbufferParameteri(target, pname gogl.GLEnum) int32 {
+	b.record("GetRenderbufferParameteri", target, pname)
+	return 0
+}
+
+// IsRenderbuffer implements gogl.Backend.
+func (b *Backend) IsRenderbuffer(renderbuffer gogl.Renderbuffer) bool {
+	b.record("IsRenderbuffer", renderbuffer)
+	return b.isLive("renderbuffer", uint32(renderbuffer))
+}
+
+// RenderbufferStorage implements gogl.Backend.
+func (b *Backend) RenderbufferStorage(target, internalFormat gogl.GLEnum, width, height int32) {
+	b.record("RenderbufferStorage", target, internalFormat, width, height)
+}
+
+// RenderbufferStorageMultisample implements gogl.Backend.
+func (b *Backend) RenderbufferStorageMultisample(target gogl.GLEnum, samples int32, internalFormat gogl.GLEnum, width, height int32) {
+	b.record("RenderbufferStorageMultisample", target, samples, internalFormat, width, height)
+}
+
+// BindTexture implements gogl.Backend.
+func (b *Backend) BindTexture(target gogl.GLEnum, texture gogl.Texture) {
+	b.record("BindTexture", target, texture)
+	b.textures[textureBinding{b.activeTexture, target}] = texture
+}
+
+// CompressedTexImage2D implements gogl.Backend.
+func (b *Backend) CompressedTexImage2D(target gogl.GLEnum, level int32, internalformat gogl.GLEnum, width, height, border, imageSize int32, pixels unsafe.Pointer) {
+	b.recordPayload(int(imageSize), pixels, "CompressedTexImage2D", target, level, internalformat, width, height, border, imageSize)
+}
+
+// CompressedTexSubImage2D implements gogl.Backend.
+func (b *Backend) CompressedTexSubImage2D(target gogl.GLEnum, level, xoffset, yoffset, width, height int32, format gogl.GLEnum, imageSize int32, pixels unsafe.Pointer) {
+	b.recordPayload(int(imageSize), pixels, "CompressedTexSubImage2D", target, level, xoffset, yoffset, width, height, format, imageSize)
+}
+
+// CopyTexImage2D implements gogl.Backend.
+func (b *Backend) CopyTexImage2D(target gogl.GLEnum, level int32, internalformat gogl.GLEnum, x, y, width, height, border int32) {
+	b.record("CopyTexImage2D", target, level, internalformat, x, y, width, height, border)
+}
+
+// CopyTexSubImage2D implements gogl.Backend.
+func (b *Backend) CopyTexSubImage2D(target gogl.GLEnum, level, xoffset, yoffset, x, y, width, height int32) {
+	b.record("CopyTexSubImage2D", target, level, xoffset, yoffset, x, y, width, height)
+}
+
+// CreateTexture implements gogl.Backend.
+func (b *Backend) CreateTexture() gogl.Texture {
+	texture := gogl.Texture(b.create("texture"))
+	b.record("CreateTexture")
+	return texture
+}
+
+// DeleteTexture implements gogl.Backend.
+func (b *Backend) DeleteTexture(texture gogl.Texture) {
+	b.record("DeleteTexture", texture)
+	if b.isLive("texture", uint32(texture)) {
+		for binding, bound := range b.textures {
+			if bound == texture {
+				delete(b.textures, binding)
+			}
+		}
+	}
+	b.delete("texture", uint32(texture))
+}
+
+// GenerateMipmap implements gogl.Backend.
+func (b *Backend) GenerateMipmap(target gogl.GLEnum) {
+	b.record("GenerateMipmap", target)
+}
+
+// IsTexture implements gogl.Backend.
+func (b *Backend) IsTexture(texture gogl.Texture) bool {
+	b.record("IsTexture", texture)
+	return b.isLive("texture", uint32(texture))
+}
+
+// TexImage2D implements gogl.Backend. The payload size is derived from the
+// dimensions, format and type of the image, and GLUnpackAlignment.
+func (b *Backend) TexImage2D(target gogl.GLEnum, level int32, internalformat gogl.GLEnum, width, height, border int32, format, xtype gogl.GLEnum, pixels unsafe.Pointer) {
+	b.recordPayload(alignedImageSize(width, height, format, xtype, b.alignment(gogl.GLUnpackAlignment)), pixels, "TexImage2D", target, level, internalformat, width, height, border, format, xtype)
+}
+
+// TexSubImage2D implements gogl.Backend. The payload size is derived from the
+// dimensions, format and type of the image, and GLUnpackAlignment.
+func (b *Backend) TexSubImage2D(target gogl.GLEnum, level, xoffset, yoffset, width, height int32, format, xtype gogl.GLEnum, pixels unsafe.Pointer) {
+	b.recordPayload(alignedImageSize(width, height, format, xtype, b.alignment(gogl.GLUnpackAlignment)), pixels, "TexSubImage2D", target, level, xoffset, yoffset, width, height, format, xtype)
+}
+
+// TexParameterf implements gogl.Backend.
+func (b *Backend) TexParameterf(target, pname gogl.GLEnum, param float32) {
+	b.record("TexParameterf", target, pname, param)
+}
+
+// TexParameteri implements gogl.Backend.
+func (b *Backend) TexParameteri(target, pname gogl.GLEnum, param int32) {
+	b.record("TexParameteri", target, pname, param)
+}
+
+// DisableVertexAttribArray implements gogl.Backend.
+func (b *Backend) DisableVertexAttribArray(index uint32) {
+	b.record("DisableVertexAttribArray", index)
+	delete(b.vertexAttribArrays, index)
+}
+
+// EnableVertexAttribArray implements gogl.Backend.
+func (b *Backend) EnableVertexAttribArray(index uint32) {
+	b.record("EnableVertexAttribArray", index)
+	b.vertexAttribArrays[index] = true
+}
+
+// GetActiveAttrib implements gogl.Backend. It returns the attributes declared
+// with SetActiveAttributes.
+func (b *Backend) GetActiveAttrib(program gogl.Program, index uint32) (string, int32, gogl.GLEnum) {
+	b.record("GetActiveAttrib", program, index)
+	p, ok := b.programs[program]
+	if !ok || int(index) >= len(p.attributes) {
+		return "", 0, 0
+	}
+	attribute := p.attributes[index]
+	return attribute.Name, attribute.Size, attribute.Type
+}
+
+// GetActiveUniform implements gogl.Backend. It returns the uniforms declared
+// with SetActiveUniforms.
+func (b *Backend) GetActiveUniform(program gogl.Program, index uint32) (string, int32, gogl.GLEnum) {
+	b.record("GetActiveUniform", program, index)
+	p, ok := b.programs[program]
+	if !ok || int(index) >= len(p.uniforms) {
+		return "", 0, 0
+	}
+	uniform := p.uniforms[index]
+	return uniform.Name, uniform.Size, uniform.Type
+}
+
+// GetAttribLocation implements gogl.Backend. If the program has attributes
+// declared with SetActiveAttributes, their locations are returned. Otherwise,
+// every name is assigned the next free location of the program when it is
+// first queried.
+func (b *Backend) GetAttribLocation(program gogl.Program, name string) int32 {
+	b.record("GetAttribLocation", program, name)
+	if p, ok := b.programs[program]; ok && p.attributes != nil {
+		for _, attribute := range p.attributes {
+			if attribute.Name == name {
+				return attribute.Location
+			}
+		}
+		return -1
+	}
+	attribs, ok := b.attribs[program]
+	if !ok {
+		attribs = make(map[string]int32)
+		b.attribs[program] = attribs
+	}
+	location, ok := attribs[name]
+	if !ok {
+		location = int32(len(attribs))
+		attribs[name] = location
+	}
+	return location
+}
+
+// GetUniformLocation implements gogl.Backend. If the program has uniforms
+// declared with SetActiveUniforms, their locations are returned. Otherwise,
+// every name is assigned the next free location of the program when it is
+// first queried.
+func (b *Backend) GetUniformLocation(program gogl.Program, name string) gogl.UniformLocation {
+	b.record("GetUniformLocation", program, name)
+	if p, ok := b.programs[program]; ok && p.uniforms != nil {
+		return declaredUniformLocation(p.uniforms, name)
+	}
+	uniforms, ok := b.uniforms[program]
+	if !ok {
+		uniforms = make(map[string]gogl.UniformLocation)
+		b.uniforms[program] = uniforms
+	}
+	location, ok := uniforms[name]
+	if !ok {
+		location = gogl.UniformLocation(len(uniforms))
+		uniforms[name] = location
+	}
+	return location
+}
+
+// Uniform1f implements gogl.Backend.
+func (b *Backend) Uniform1f(location gogl.UniformLocation, v0 float32) {
+	b.record("Uniform1f", location, v0)
+}
+
+// Uniform1fv implements gogl.Backend.
+func (b *Backend) Uniform1fv(location gogl.UniformLocation, count int32, value []float32) {
+	b.record("Uniform1fv", location, count, copyFloats(value))
+}
+
+// Uniform1i implements gogl.Backend.
+func (b *Backend) Uniform1i(location gogl.UniformLocation, v0 int32) {
+	b.record("Uniform1i", location, v0)
+}
+
+// Uniform1iv implements gogl.Backend.
+func (b *Backend) Uniform1iv(location gogl.UniformLocation, count int32, value []int32) {
+	b.record("Uniform1iv", location, count, copyInts(value))
+}
+
+// Uniform2f implements gogl.Backend.
+func (b *Backend) Uniform2f(location gogl.UniformLocation, v0, v1 float32) {
+	b.record("Uniform2f", location, v0, v1)
+}
+
+// Uniform2fv implements gogl.Backend.
+func (b *Backend) Uniform2fv(location gogl.UniformLocation, count int32, value []float32) {
+	b.record("Uniform2fv", location, count, copyFloats(value))
+}
+
+// Uniform2i implements gogl.Backend.
+func (b *Backend) Uniform2i(location gogl.UniformLocation, v0, v1 int32) {
+	b.record("Uniform2i", location, v0, v1)
+}
+
+// Uniform2iv implements gogl.Backend.
+func (b *Backend) Uniform2iv(location gogl.UniformLocation, count int32, value []int32) {
+	b.record("Uniform2iv", location, count, copyInts(value))
+}
+
+// Uniform3f implements gogl.Backend.
+func (b *Backend) Uniform3f(location gogl.UniformLocation, v0, v1, v2 float32) {
+	b.record("Uniform3f", location, v0, v1, v2)
+}
+
+// Uniform3fv implements gogl.Backend.
+func (b *Backend) Uniform3fv(location gogl.UniformLocation, count int32, value []float32) {
+	b.record("Uniform3fv", location, count, copyFloats(value))
+}
+
+// Uniform3i implements gogl.Backend.
+func (b *Backend) Uniform3i(location gogl.UniformLocation, v0, v1, v2 int32) {
+	b.record("Uniform3i", location, v0, v1, v2)
+}
+
+// Uniform3iv implements gogl.Backend.
+func (b *Backend) Uniform3iv(location gogl.UniformLocation, count int32, value []int32) {
+	b.record("Uniform3iv", location, count, copyInts(value))
+}
+
+// Uniform4f implements gogl.Backend.
+func (b *Backend) Uniform4f(location gogl.UniformLocation, v0, v1, v2, v3 float32) {
+	b.record("Uniform4f", location, v0, v1, v2, v3)
+}
+
+// Uniform4fv implements gogl.Backend.
+func (b *Backend) Uniform4fv(location gogl.UniformLocation, count int32, value []float32) {
+	b.record("Uniform4fv", location, count, copyFloats(value))
+}
+
+// Uniform4i implements gogl.Backend.
+func (b *Backend) Uniform4i(location gogl.UniformLocation, v0, v1, v2, v3 int32) {
+	b.record("Uniform4i", location, v0, v1, v2, v3)
+}
+
+// Uniform4iv implements gogl.Backend.
+func (b *Backend) Uniform4iv(location gogl.UniformLocation, count int32, value []int32) {
+	b.record("Uniform4iv", location, count, copyInts(value))
+}
+
+// UniformMatrix2fv implements gogl.Backend.
+func (b *Backend) UniformMatrix2fv(location gogl.UniformLocation, count int32, transpose bool, value []float32) {
+	b.record("UniformMatrix2fv", location, count, transpose, copyFloats(value))
+}
+
+// UniformMatrix3fv implements gogl.Backend.
+func (b *Backend) UniformMatrix3fv(location gogl.UniformLocation, count int32, transpose bool, value []float32) {
+	b.record("UniformMatrix3fv", location, count, transpose, copyFloats(value))
+}
+
+// UniformMatrix4fv implements gogl.Backend.
+func (b *Backend) UniformMatrix4fv(location gogl.UniformLocation, count int32, transpose bool, value []float32) {
+	b.record("UniformMatrix4fv", location, count, transpose, copyFloats(value))
+}
+
+// VertexAttrib1f implements gogl.Backend.
+func (b *Backend) VertexAttrib1f(index uint32, v0 float32) {
+	b.record("VertexAttrib1f", index, v0)
+}
+
+// VertexAttrib2f implements gogl.Backend.
+func (b *Backend) VertexAttrib2f(index uint32, v0, v1 float32) {
+	b.record("VertexAttrib2f", index, v0, v1)
+}
+
+// VertexAttrib3f implements gogl.Backend.
+func (b *Backend) VertexAttrib3f(index uint32, v0, v1, v2 float32) {
+	b.record("VertexAttrib3f", index, v0, v1, v2)
+}
+
+// VertexAttrib4f implements gogl.Backend.
+func (b *Backend) VertexAttrib4f(index uint32, v0, v1, v2, v3 float32) {
+	b.record("VertexAttrib4f", index, v0, v1, v2, v3)
+}
+
+// VertexAttrib1fv implements gogl.Backend.
+func (b *Backend) VertexAttrib1fv(index uint32, value []float32) {
+	b.record("VertexAttrib1fv", index, copyFloats(value))
+}
+
+// VertexAttrib2fv implements gogl.Backend.
+func (b *Backend) VertexAttrib2fv(index uint32, value []float32) {
+	b.record("VertexAttrib2fv", index, copyFloats(value))
+}
+
+// VertexAttrib3fv implements gogl.Backend.
+func (b *Backend) VertexAttrib3fv(index uint32, value []float32) {
+	b.record("VertexAttrib3fv", index, copyFloats(value))
+}
+
+// VertexAttrib4fv implements gogl.Backend.
+func (b *Backend) VertexAttrib4fv(index uint32, value []float32) {
+	b.record("VertexAttrib4fv", index, copyFloats(value))
+}
+
+// VertexAttribDivisor implements gogl.Backend.
+func (b *Backend) VertexAttribDivisor(index, divisor uint32) {
+	b.record("VertexAttribDivisor", index, divisor)
+	pointer := b.attribPointers[index]
+	pointer.Divisor = divisor
+	b.attribPointers[index] = pointer
+}
+
+// VertexAttribPointer implements gogl.Backend.
+func (b *Backend) VertexAttribPointer(index uint32, size int32, xtype gogl.GLEnum, normalized bool, stride int32, offset int) {
+	b.record("VertexAttribPointer", index, size, xtype, normalized, stride, offset)
+	b.attribPointers[index] = AttribPointer{
+		Buffer:     gogl.Buffer(b.bindings[gogl.GLArrayBuffer]),
+		Size:       size,
+		Type:       xtype,
+		Normalized: normalized,
+		Stride:     stride,
+		Offset:     offset,
+		Divisor:    b.attribPointers[index].Divisor,
+	}
+}
+
+// BindVertexArray implements gogl.Backend. The vertex attribute arrays and the
+// GLElementArrayBuffer binding are stored in the bound vertex array, and
+// restored when it is bound again.
+func (b *Backend) BindVertexArray(array gogl.VertexArray) {
+	b.record("BindVertexArray", array)
+	b.switchVertexArray(array)
+}
+
+func (b *Backend) switchVertexArray(array gogl.VertexArray) {
+	current := gogl.VertexArray(b.bindings[gogl.GLVertexArrayBinding])
+	b.vertexArrays[current] = &vertexArrayState{
+		vertexAttribArrays: b.vertexAttribArrays,
+		attribPointers:     b.attribPointers,
+		elementArrayBuffer: b.bindings[gogl.GLElementArrayBuffer],
+	}
+	state, ok := b.vertexArrays[array]
+	if !ok {
+		state = &vertexArrayState{
+			vertexAttribArrays: make(map[uint32]bool),
+			attribPointers:     make(map[uint32]AttribPointer),
+		}
+	}
+	delete(b.vertexArrays, array)
+	b.vertexAttribArrays = state.vertexAttribArrays
+	b.attribPointers = state.attribPointers
+	b.bindings[gogl.GLElementArrayBuffer] = state.elementArrayBuffer
+	b.bindings[gogl.GLVertexArrayBinding] = uint32(array)
+}
+
+// CreateVertexArray implements gogl.Backend.
+func (b *Backend) CreateVertexArray() gogl.VertexArray {
+	array := gogl.VertexArray(b.create("vertex array"))
+	b.record("CreateVertexArray")
+	return array
+}
+
+// DeleteVertexArray implements gogl.Backend. Deleting the bound vertex array
+// binds 0 instead.
+func (b *Backend) DeleteVertexArray(array gogl.VertexArray) {
+	b.record("DeleteVertexArray", array)
+	if !b.isLive("vertex array", uint32(array)) {
+		return
+	}
+	if b.bindings[gogl.GLVertexArrayBinding] == uint32(array) {
+		b.switchVertexArray(0)
+	}
+	delete(b.vertexArrays, array)
+	b.delete("vertex array", uint32(array))
+}
+
+// IsVertexArray implements gogl.Backend.
+func (b *Backend) IsVertexArray(array gogl.VertexArray) bool {
+	b.record("IsVertexArray", array)
+	return b.isLive("vertex array", uint32(array))
+}
+
+// Scissor implements gogl.Backend.
+func (b *Backend) Scissor(x, y, width, height int32) {
+	b.record("Scissor", x, y, width, height)
+}
+
+// Viewport implements gogl.Backend.
+func (b *Backend) Viewport(x, y, width, height int32) {
+	b.record("Viewport", x, y, width, height)
+}
+
+func boolToInt32(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func copyFloats(value []float32) []float32 {
+	return append([]float32(nil), value...)
+}
+
+func copyInts(value []int32) []int32 {
+	return append([]int32(nil), value...)
+}
+
+// declaredUniformLocation returns the location of the named uniform or array
+// element, assuming the elements of arrays have consecutive locations.
+func declaredUniformLocation(uniforms []gogl.ActiveUniform, name string) gogl.UniformLocation {
+	for _, uniform := range uniforms {
+		base := strings.TrimSuffix(uniform.Name, "[0]")
+		if name == uniform.Name || name == base {
+			return uniform.Location
+		}
+		if !strings.HasPrefix(name, base+"[") || !strings.HasSuffix(name, "]") {
+			continue
+		}
+		index, err := strconv.Atoi(name[len(base)+1 : len(name)-1])
+		if err == nil && index >= 0 && index < int(uniform.Size) {
+			return uniform.Location + gogl.UniformLocation(index)
+		}
+	}
+	return -1
+}
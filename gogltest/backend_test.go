@@ -0,0 +1,171 @@
+package gogltest
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestBackendBindings(t *testing.T) {
+	b := NewBackend()
+	buffer := b.CreateBuffer()
+	b.BindBuffer(gogl.GLArrayBuffer, buffer)
+	if got := b.Binding(gogl.GLArrayBuffer); got != uint32(buffer) {
+		t.Errorf("Binding(GL_ARRAY_BUFFER) = %d, want %d", got, buffer)
+	}
+
+	framebuffer := b.CreateFramebuffer()
+	b.BindFramebuffer(gogl.GLFramebuffer, framebuffer)
+	for _, target := range []gogl.GLEnum{gogl.GLFramebuffer, gogl.GLReadFramebuffer, gogl.GLDrawFramebuffer} {
+		if got := b.Binding(target); got != uint32(framebuffer) {
+			t.Errorf("Binding(%v) after binding GL_FRAMEBUFFER = %d, want %d", target, got, framebuffer)
+		}
+	}
+	other := b.CreateFramebuffer()
+	b.BindFramebuffer(gogl.GLReadFramebuffer, other)
+	if read, draw := b.Binding(gogl.GLReadFramebuffer), b.Binding(gogl.GLDrawFramebuffer); read != uint32(other) || draw != uint32(framebuffer) {
+		t.Errorf("read and draw framebuffer bindings = %d, %d, want %d, %d", read, draw, other, framebuffer)
+	}
+
+	first, second := b.CreateTexture(), b.CreateTexture()
+	b.BindTexture(gogl.GLTexture2D, first)
+	b.ActiveTexture(gogl.GLTexture1)
+	b.BindTexture(gogl.GLTexture2D, second)
+	if got := b.BoundTexture(gogl.GLTexture0, gogl.GLTexture2D); got != first {
+		t.Errorf("BoundTexture(GL_TEXTURE0) = %d, want %d", got, first)
+	}
+	if got := b.BoundTexture(gogl.GLTexture1, gogl.GLTexture2D); got != second {
+		t.Errorf("BoundTexture(GL_TEXTURE1) = %d, want %d", got, second)
+	}
+	if got := b.ActiveTextureUnit(); got != gogl.GLTexture1 {
+		t.Errorf("ActiveTextureUnit() = %v, want GL_TEXTURE1", got)
+	}
+}
+
+func TestBackendDelete(t *testing.T) {
+	b := NewBackend()
+	buffer := b.CreateBuffer()
+	b.BindBuffer(gogl.GLElementArrayBuffer, buffer)
+	texture := b.CreateTexture()
+	b.BindTexture(gogl.GLTextureCubeMap, texture)
+	framebuffer := b.CreateFramebuffer()
+	b.BindFramebuffer(gogl.GLFramebuffer, framebuffer)
+	renderbuffer := b.CreateRenderbuffer()
+	b.BindRenderbuffer(gogl.GLRenderbuffer, renderbuffer)
+
+	b.DeleteBuffer(buffer)
+	b.DeleteTexture(texture)
+	b.DeleteFramebuffer(framebuffer)
+	b.DeleteRenderbuffer(renderbuffer)
+
+	for _, target := range []gogl.GLEnum{gogl.GLElementArrayBuffer, gogl.GLFramebuffer, gogl.GLReadFramebuffer, gogl.GLDrawFramebuffer, gogl.GLRenderbuffer} {
+		if got := b.Binding(target); got != 0 {
+			t.Errorf("Binding(%v) after deleting the bound object = %d, want 0", target, got)
+		}
+	}
+	if got := b.BoundTexture(gogl.GLTexture0, gogl.GLTextureCubeMap); got != 0 {
+		t.Errorf("BoundTexture() after deleting the bound texture = %d, want 0", got)
+	}
+	for _, deleted := range []struct {
+		kind string
+		name uint32
+		is   bool
+	}{
+		{"buffer", uint32(buffer), b.IsBuffer(buffer)},
+		{"texture", uint32(texture), b.IsTexture(texture)},
+		{"framebuffer", uint32(framebuffer), b.IsFramebuffer(framebuffer)},
+		{"renderbuffer", uint32(renderbuffer), b.IsRenderbuffer(renderbuffer)},
+	} {
+		if !b.Deleted(deleted.kind, deleted.name) || deleted.is {
+			t.Errorf("%s %d: Deleted() = %t, IsX() = %t, want true, false", deleted.kind, deleted.name, b.Deleted(deleted.kind, deleted.name), deleted.is)
+		}
+	}
+	if b.Deleted("buffer", 2) {
+		t.Error(`Deleted("buffer", 2) = true for a buffer that was never created`)
+	}
+}
+
+func TestBackendAttachments(t *testing.T) {
+	b := NewBackend()
+	framebuffer := b.CreateFramebuffer()
+	texture := b.CreateTexture()
+	renderbuffer := b.CreateRenderbuffer()
+	b.BindFramebuffer(gogl.GLFramebuffer, framebuffer)
+	b.FramebufferTexture2D(gogl.GLFramebuffer, gogl.GLColorAttachment0, gogl.GLTextureCubeMapNegativeY, texture, 2)
+	b.FramebufferRenderbuffer(gogl.GLFramebuffer, gogl.GLDepthAttachment, gogl.GLRenderbuffer, renderbuffer)
+
+	want := gogl.FramebufferAttachment{
+		Attachment:         gogl.GLColorAttachment0,
+		ObjectType:         gogl.GLTexture,
+		ObjectName:         uint32(texture),
+		TextureLevel:       2,
+		TextureCubeMapFace: gogl.GLTextureCubeMapNegativeY,
+	}
+	if got := b.Attachment(framebuffer, gogl.GLColorAttachment0); got != want {
+		t.Errorf("Attachment(GL_COLOR_ATTACHMENT0) = %+v, want %+v", got, want)
+	}
+	if got := b.GetFramebufferAttachmentParameteri(gogl.GLFramebuffer, gogl.GLDepthAttachment, gogl.GLFramebufferAttachmentObjectName); got != int32(renderbuffer) {
+		t.Errorf("GL_FRAMEBUFFER_ATTACHMENT_OBJECT_NAME of GL_DEPTH_ATTACHMENT = %d, want %d", got, renderbuffer)
+	}
+
+	b.FramebufferTexture2D(gogl.GLFramebuffer, gogl.GLColorAttachment0, gogl.GLTexture2D, 0, 0)
+	if got := b.Attachment(framebuffer, gogl.GLColorAttachment0); got.ObjectType != gogl.GLNone {
+		t.Errorf("Attachment(GL_COLOR_ATTACHMENT0) after detaching = %+v, want GL_NONE", got)
+	}
+
+	b.DeleteFramebuffer(framebuffer)
+	if got := b.Attachment(framebuffer, gogl.GLDepthAttachment); got.ObjectType != gogl.GLNone {
+		t.Errorf("Attachment(GL_DEPTH_ATTACHMENT) of a deleted framebuffer = %+v, want GL_NONE", got)
+	}
+}
+
+func TestBackendPayloads(t *testing.T) {
+	tests := []struct {
+		name      string
+		width     int32
+		height    int32
+		format    gogl.GLEnum
+		xtype     gogl.GLEnum
+		alignment int32
+		want      int
+	}{
+		{"RGBA", 3, 2, gogl.GLRGBA, gogl.GLUInt8, 4, 24},
+		{"RGB aligned", 3, 2, gogl.GLRGB, gogl.GLUInt8, 4, 12 + 9},
+		{"RGB packed", 3, 2, gogl.GLRGB, gogl.GLUInt8, 1, 18},
+		{"red", 5, 2, gogl.GLRed, gogl.GLUInt8, 4, 8 + 5},
+		{"RG float", 3, 3, gogl.GLRG, gogl.GLFloat32, 8, 2*24 + 24},
+		{"depth stencil", 2, 2, gogl.GLDepthStencil, gogl.GLUInt248, 4, 16},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := NewBackend()
+			b.PixelStorei(gogl.GLUnpackAlignment, test.alignment)
+			pixels := make([]byte, test.want)
+			for i := range pixels {
+				pixels[i] = byte(i)
+			}
+			b.TexImage2D(gogl.GLTexture2D, 0, test.format, test.width, test.height, 0, test.format, test.xtype, unsafe.Pointer(&pixels[0]))
+			call := b.CallsTo("TexImage2D")[0]
+			if call.Size != test.want || len(call.Data) != test.want || call.Data[test.want-1] != byte(test.want-1) {
+				t.Errorf("TexImage2D() recorded %d bytes of data, want %d", call.Size, test.want)
+			}
+		})
+	}
+}
+
+func TestBackendCalls(t *testing.T) {
+	b := NewBackend()
+	b.DrawArrays(gogl.GLTriangles, 0, 36)
+	b.DrawBuffers([]gogl.GLEnum{gogl.GLColorAttachment0, gogl.GLColorAttachment1})
+	if got := b.Calls[0].String(); got != "DrawArrays(GL_TRIANGLES, 0, 36)" {
+		t.Errorf("Calls[0].String() = %q, want %q", got, "DrawArrays(GL_TRIANGLES, 0, 36)")
+	}
+	if got := len(b.CallsTo("DrawBuffers")); got != 1 {
+		t.Errorf("len(CallsTo(DrawBuffers)) = %d, want 1", got)
+	}
+	b.ClearCalls()
+	if len(b.Calls) != 0 {
+		t.Errorf("Calls after ClearCalls() = %v, want none", b.Calls)
+	}
+}
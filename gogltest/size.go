@@ -0,0 +1,15 @@
+package gogltest
+
+import "github.com/pegasus-toolset/gogl"
+
+// alignedImageSize returns the size in bytes of an image with the given
+// dimensions, format and type, whose rows start at multiples of alignment
+// bytes. The last row is not padded.
+func alignedImageSize(width, height int32, format, xtype gogl.GLEnum, alignment int32) int {
+	if width <= 0 || height <= 0 {
+		return 0
+	}
+	row := int(width) * gogl.PixelSize(format, xtype)
+	stride := (row + int(alignment) - 1) / int(alignment) * int(alignment)
+	return (int(height)-1)*stride + row
+}
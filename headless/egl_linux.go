@@ -0,0 +1,190 @@
+//go:build linux
+// +build linux
+
+package headless
+
+/*
+#cgo linux pkg-config: egl
+#include <stdlib.h>
+#include <EGL/egl.h>
+#include <EGL/eglext.h>
+
+#ifndef EGL_PLATFORM_SURFACELESS_MESA
+#define EGL_PLATFORM_SURFACELESS_MESA 0x31DD
+#endif
+
+static EGLDisplay gogl_get_display(int surfaceless) {
+	if (surfaceless) {
+		PFNEGLGETPLATFORMDISPLAYEXTPROC getPlatformDisplay =
+			(PFNEGLGETPLATFORMDISPLAYEXTPROC) eglGetProcAddress("eglGetPlatformDisplayEXT");
+		if (getPlatformDisplay != NULL) {
+			EGLDisplay display = getPlatformDisplay(EGL_PLATFORM_SURFACELESS_MESA, EGL_DEFAULT_DISPLAY, NULL);
+			if (display != EGL_NO_DISPLAY) {
+				return display;
+			}
+		}
+	}
+	return eglGetDisplay(EGL_DEFAULT_DISPLAY);
+}
+
+static EGLBoolean gogl_choose_config(EGLDisplay display, EGLint surfaceType, EGLConfig *config) {
+	EGLint attribs[] = {
+		EGL_SURFACE_TYPE, surfaceType,
+		EGL_RENDERABLE_TYPE, EGL_OPENGL_BIT,
+		EGL_RED_SIZE, 8,
+		EGL_GREEN_SIZE, 8,
+		EGL_BLUE_SIZE, 8,
+		EGL_ALPHA_SIZE, 8,
+		EGL_DEPTH_SIZE, 24,
+		EGL_STENCIL_SIZE, 8,
+		EGL_NONE
+	};
+	EGLint count = 0;
+	if (!eglChooseConfig(display, attribs, config, 1, &count)) {
+		return EGL_FALSE;
+	}
+	return count > 0;
+}
+
+static EGLSurface gogl_create_pbuffer(EGLDisplay display, EGLConfig config, EGLint width, EGLint height) {
+	EGLint attribs[] = {
+		EGL_WIDTH, width,
+		EGL_HEIGHT, height,
+		EGL_NONE
+	};
+	return eglCreatePbufferSurface(display, config, attribs);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// Context is an OpenGL context created through EGL, preferably on Mesa's
+// surfaceless platform (e.g., llvmpipe), without a window.
+type Context struct {
+	display C.EGLDisplay
+	context C.EGLContext
+	surface C.EGLSurface
+}
+
+// New creates a Context and makes it current on the calling thread.
+func New(options Options) (*Context, error) {
+	clientExtensions := C.GoString(C.eglQueryString(C.EGLDisplay(C.EGL_NO_DISPLAY), C.EGL_EXTENSIONS))
+	surfaceless := hasExtension(clientExtensions, "EGL_MESA_platform_surfaceless")
+
+	ctx := &Context{
+		display: C.gogl_get_display(boolToInt(surfaceless)),
+		context: C.EGLContext(C.EGL_NO_CONTEXT),
+		surface: C.EGLSurface(C.EGL_NO_SURFACE),
+	}
+	if ctx.display == C.EGLDisplay(C.EGL_NO_DISPLAY) {
+		return nil, fmt.Errorf("headless: no EGL display available")
+	}
+	if C.eglInitialize(ctx.display, nil, nil) == C.EGL_FALSE {
+		return nil, eglError("eglInitialize")
+	}
+	if C.eglBindAPI(C.EGL_OPENGL_API) == C.EGL_FALSE {
+		ctx.Destroy()
+		return nil, eglError("eglBindAPI")
+	}
+
+	displayExtensions := C.GoString(C.eglQueryString(ctx.display, C.EGL_EXTENSIONS))
+	pbuffer := options.Width > 0 && options.Height > 0 ||
+		!hasExtension(displayExtensions, "EGL_KHR_surfaceless_context")
+
+	var surfaceType C.EGLint
+	if pbuffer {
+		surfaceType = C.EGL_PBUFFER_BIT
+	}
+	var config C.EGLConfig
+	if C.gogl_choose_config(ctx.display, surfaceType, &config) == C.EGL_FALSE {
+		ctx.Destroy()
+		return nil, fmt.Errorf("headless: no matching EGL config")
+	}
+
+	if pbuffer {
+		width, height := options.Width, options.Height
+		if width <= 0 || height <= 0 {
+			width, height = 1, 1
+		}
+		ctx.surface = C.gogl_create_pbuffer(ctx.display, config, C.EGLint(width), C.EGLint(height))
+		if ctx.surface == C.EGLSurface(C.EGL_NO_SURFACE) {
+			ctx.Destroy()
+			return nil, eglError("eglCreatePbufferSurface")
+		}
+	}
+
+	ctx.context = C.eglCreateContext(ctx.display, config, C.EGLContext(C.EGL_NO_CONTEXT), nil)
+	if ctx.context == C.EGLContext(C.EGL_NO_CONTEXT) {
+		ctx.Destroy()
+		return nil, eglError("eglCreateContext")
+	}
+	if err := ctx.MakeCurrent(); err != nil {
+		ctx.Destroy()
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// MakeCurrent makes the Context current on the calling thread.
+func (ctx *Context) MakeCurrent() error {
+	if C.eglMakeCurrent(ctx.display, ctx.surface, ctx.surface, ctx.context) == C.EGL_FALSE {
+		return eglError("eglMakeCurrent")
+	}
+	return nil
+}
+
+// Destroy releases the Context from the calling thread and frees all of its
+// resources. The Context must not be used afterwards.
+func (ctx *Context) Destroy() error {
+	noSurface := C.EGLSurface(C.EGL_NO_SURFACE)
+	C.eglMakeCurrent(ctx.display, noSurface, noSurface, C.EGLContext(C.EGL_NO_CONTEXT))
+	if ctx.context != C.EGLContext(C.EGL_NO_CONTEXT) {
+		C.eglDestroyContext(ctx.display, ctx.context)
+		ctx.context = C.EGLContext(C.EGL_NO_CONTEXT)
+	}
+	if ctx.surface != noSurface {
+		C.eglDestroySurface(ctx.display, ctx.surface)
+		ctx.surface = noSurface
+	}
+	if C.eglTerminate(ctx.display) == C.EGL_FALSE {
+		return eglError("eglTerminate")
+	}
+	return nil
+}
+
+// getProcAddress returns the address of the OpenGL function with the given
+// name.
+func (ctx *Context) getProcAddress(name string) unsafe.Pointer {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return unsafe.Pointer(C.eglGetProcAddress(cname))
+}
+
+// eglError returns an error describing the last EGL error of the calling
+// thread.
+func eglError(function string) error {
+	return fmt.Errorf("headless: %s failed with EGL error 0x%04X", function, int(C.eglGetError()))
+}
+
+// hasExtension reports whether the space-separated list of extensions contains
+// the extension.
+func hasExtension(extensions, extension string) bool {
+	for _, e := range strings.Fields(extensions) {
+		if e == extension {
+			return true
+		}
+	}
+	return false
+}
+
+func boolToInt(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+// Package headless creates OpenGL contexts without a window, e.g., for tests
+// and offscreen tools running on machines without a display server.
+//
+// A Context is bound to the OS thread it is made current on. Callers should
+// lock the calling goroutine to its thread with runtime.LockOSThread before
+// calling New, and must make all OpenGL calls from that goroutine:
+//
+//	runtime.LockOSThread()
+//	ctx, err := headless.New(headless.Options{})
+//	if err != nil {
+//		// handle error
+//	}
+//	defer ctx.Destroy()
+//	if err := gogl.InitWithBackend(ctx.Backend()); err != nil {
+//		// handle error
+//	}
+//
+// Unless Options.Width and Options.Height are set, a Context has no default
+// framebuffer, and rendering has to target a gogl.Framebuffer instead.
+package headless
+
+import (
+	"errors"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+// ErrUnsupported is returned by New on platforms without support for headless
+// contexts.
+var ErrUnsupported = errors.New("headless: headless contexts are not supported on this platform")
+
+// Options configure the Context created by New.
+type Options struct {
+	// Width and Height are the size of the default framebuffer. If either is
+	// 0, the Context is created without a default framebuffer if the driver
+	// supports it.
+	Width, Height int
+}
+
+// Backend returns a gogl.Backend loading its function pointers from the
+// Context. Pass it to gogl.InitWithBackend after making the Context current.
+func (ctx *Context) Backend() gogl.Backend {
+	return gogl.NewGLBackendWithProcAddrFunc(ctx.getProcAddress)
+}
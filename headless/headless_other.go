@@ -0,0 +1,29 @@
+//go:build !linux
+// +build !linux
+
+package headless
+
+import "unsafe"
+
+// Context is an OpenGL context without a window. Headless contexts are only
+// supported on Linux.
+type Context struct{}
+
+// New returns ErrUnsupported, as headless contexts are only supported on Linux.
+func New(options Options) (*Context, error) {
+	return nil, ErrUnsupported
+}
+
+// MakeCurrent returns ErrUnsupported.
+func (ctx *Context) MakeCurrent() error {
+	return ErrUnsupported
+}
+
+// Destroy returns ErrUnsupported.
+func (ctx *Context) Destroy() error {
+	return ErrUnsupported
+}
+
+func (ctx *Context) getProcAddress(name string) unsafe.Pointer {
+	return nil
+}
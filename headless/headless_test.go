@@ -0,0 +1,39 @@
+package headless_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/headless"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		options headless.Options
+	}{
+		{"surfaceless", headless.Options{}},
+		{"pbuffer", headless.Options{Width: 64, Height: 32}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+
+			ctx, err := headless.New(test.options)
+			if err != nil {
+				t.Skipf("no headless context available: %v", err)
+			}
+			defer ctx.Destroy()
+			if err := gogl.InitWithBackend(ctx.Backend()); err != nil {
+				t.Fatalf("InitWithBackend() error = %v", err)
+			}
+			version := gogl.GetString(gogl.GLVersion)
+			if major, _ := gogl.Version(); major == 0 {
+				t.Errorf("GetString(GL_VERSION) = %q, want a version", version)
+			}
+			t.Logf("GL_VERSION = %q", version)
+		})
+	}
+}
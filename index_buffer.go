@@ -0,0 +1,101 @@
+package gogl
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Index is the set of types that can be stored in an IndexBuffer.
+type Index interface {
+	~uint8 | ~uint16 | ~uint32
+}
+
+// IndexBuffer is a Buffer of indices for DrawElements, which remembers the type
+// and count of the indices stored in it, so that they cannot be mismatched
+// when drawing.
+type IndexBuffer struct {
+	// Buffer is the Buffer storing the indices.
+	Buffer Buffer
+	// Type is the type of the indices, one of GLUInt8, GLUInt16 or GLUInt32.
+	Type GLEnum
+	// Count is the number of indices.
+	Count int32
+}
+
+// NewIndexBuffer creates an IndexBuffer and fills it with indices. The type of
+// the indices is derived from the element type of the slice.
+//
+// The IndexBuffer is left bound to GLElementArrayBuffer.
+func NewIndexBuffer[E Index](indices []E, usage GLEnum) *IndexBuffer {
+	ib := &IndexBuffer{Buffer: CreateBuffer()}
+	SetIndexData(ib, indices, usage)
+	return ib
+}
+
+// SetIndexData replaces the indices stored in the IndexBuffer, updating its
+// type and count.
+//
+// The IndexBuffer is left bound to GLElementArrayBuffer.
+func SetIndexData[E Index](ib *IndexBuffer, indices []E, usage GLEnum) {
+	ib.Bind()
+	BufferData(GLElementArrayBuffer, indices, usage)
+	ib.Type = indexType(indices)
+	ib.Count = int32(len(indices))
+}
+
+// indexType returns the GLEnum of the element type of the slice.
+func indexType[E Index](indices []E) GLEnum {
+	var index E
+	switch unsafe.Sizeof(index) {
+	case 1:
+		return GLUInt8
+	case 2:
+		return GLUInt16
+	default:
+		return GLUInt32
+	}
+}
+
+// Bind binds the IndexBuffer to GLElementArrayBuffer.
+func (ib *IndexBuffer) Bind() {
+	BindBuffer(GLElementArrayBuffer, ib.Buffer)
+}
+
+// Draw binds the IndexBuffer and renders primitives using all of its indices.
+func (ib *IndexBuffer) Draw(mode GLEnum) {
+	ib.DrawRange(mode, 0, ib.Count)
+}
+
+// DrawRange binds the IndexBuffer and renders primitives using count of its
+// indices, starting at the index first. Nothing is drawn if the range is
+// empty. DrawRange panics if the range is out of bounds.
+func (ib *IndexBuffer) DrawRange(mode GLEnum, first, count int32) {
+	if first < 0 || count < 0 || first+count > ib.Count {
+		panic(fmt.Sprintf("gogl: IndexBuffer.DrawRange: range [%d:%d] out of bounds for %d indices", first, first+count, ib.Count))
+	}
+	if count == 0 {
+		return
+	}
+	ib.Bind()
+	DrawElements(mode, count, ib.Type, int(first)*typeSize(ib.Type))
+}
+
+// DrawInstanced binds the IndexBuffer and renders instanceCount instances of
+// the primitives Draw would render. It returns ErrInstancingUnsupported if
+// the context does not support instanced drawing.
+func (ib *IndexBuffer) DrawInstanced(mode GLEnum, instanceCount int32) error {
+	if !InstancingSupported() {
+		return ErrInstancingUnsupported
+	}
+	if ib.Count == 0 {
+		return nil
+	}
+	ib.Bind()
+	return DrawElementsInstanced(mode, ib.Count, ib.Type, 0, instanceCount)
+}
+
+// Delete deletes the Buffer of the IndexBuffer.
+func (ib *IndexBuffer) Delete() {
+	ib.Buffer.Delete()
+	ib.Count = 0
+}
@@ -0,0 +1,84 @@
+package gogl_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestIndexBuffer(t *testing.T) {
+	tests := []struct {
+		name    string
+		newIB   func() *gogl.IndexBuffer
+		xtype   string
+		size    int
+		dataLen int
+	}{
+		{"uint8", func() *gogl.IndexBuffer { return gogl.NewIndexBuffer([]uint8{0, 1, 2, 2, 3, 0}, gogl.GLStaticDraw) }, "GL_UNSIGNED_BYTE", 1, 6},
+		{"uint16", func() *gogl.IndexBuffer { return gogl.NewIndexBuffer([]uint16{0, 1, 2, 2, 3, 0}, gogl.GLStaticDraw) }, "GL_UNSIGNED_SHORT", 2, 12},
+		{"uint32", func() *gogl.IndexBuffer { return gogl.NewIndexBuffer([]uint32{0, 1, 2, 2, 3, 0}, gogl.GLStaticDraw) }, "GL_UNSIGNED_INT", 4, 24},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			ib := test.newIB()
+			if ib.Count != 6 {
+				t.Errorf("Count = %d, want 6", ib.Count)
+			}
+			if got := ib.Type.String(); got != test.xtype {
+				t.Errorf("Type = %s, want %s", got, test.xtype)
+			}
+			if got := len(b.CallsTo("BufferData")[0].Data); got != test.dataLen {
+				t.Errorf("uploaded %d bytes, want %d", got, test.dataLen)
+			}
+
+			b.ClearCalls()
+			ib.Draw(gogl.GLTriangles)
+			ib.DrawRange(gogl.GLTriangles, 3, 3)
+			ib.DrawRange(gogl.GLTriangles, 6, 0)
+
+			bind := fmt.Sprintf("BindBuffer(GL_ELEMENT_ARRAY_BUFFER, %d)", ib.Buffer)
+			want := []string{
+				bind,
+				fmt.Sprintf("DrawElements(GL_TRIANGLES, 6, %s, 0)", test.xtype),
+				bind,
+				fmt.Sprintf("DrawElements(GL_TRIANGLES, 3, %s, %d)", test.xtype, 3*test.size),
+			}
+			if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+				t.Errorf("calls = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIndexBufferDrawRangeOutOfBounds(t *testing.T) {
+	initBackend(t, "3.3.0", "")
+	ib := gogl.NewIndexBuffer([]uint16{0, 1, 2}, gogl.GLStaticDraw)
+	for _, r := range [][2]int32{{-1, 2}, {1, -1}, {2, 2}} {
+		func() {
+			want := fmt.Sprintf("gogl: IndexBuffer.DrawRange: range [%d:%d] out of bounds for 3 indices", r[0], r[0]+r[1])
+			defer func() {
+				if got := recover(); got != want {
+					t.Errorf("DrawRange(%d, %d) panic = %v, want %q", r[0], r[1], got, want)
+				}
+			}()
+			ib.DrawRange(gogl.GLTriangles, r[0], r[1])
+		}()
+	}
+}
+
+func TestDrawElements(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	gogl.DrawElements(gogl.GLTriangles, 3, gogl.GLUInt16, 6)
+	gogl.DrawElements(gogl.GLTriangleStrip, 4, gogl.GLUInt32, 8)
+
+	want := []string{
+		"DrawElements(GL_TRIANGLES, 3, GL_UNSIGNED_SHORT, 6)",
+		"DrawElements(GL_TRIANGLE_STRIP, 4, GL_UNSIGNED_INT, 8)",
+	}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
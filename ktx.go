@@ -0,0 +1,227 @@
+package gogl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The identifiers at the start of KTX and KTX2 files.
+var (
+	ktx1Identifier = []byte("\xABKTX 11\xBB\r\n\x1A\n")
+	ktx2Identifier = []byte("\xABKTX 20\xBB\r\n\x1A\n")
+)
+
+// The sizes of the headers of KTX and KTX2 files, and of a level of the level
+// index of KTX2 files.
+const (
+	ktx1HeaderSize     = 64
+	ktx2HeaderSize     = 80
+	ktx2LevelIndexSize = 24
+)
+
+// ParseKTX parses a KTX or KTX2 file with all of its mipmap levels and cube
+// map faces. Upload uploads the parsed texture.
+//
+// KTX files may store any format OpenGL supports, whereas KTX2 files are
+// limited to the uncompressed 8 bit RGB, RGBA and BGRA formats, and to the
+// compressed formats supported by ParseDDS except for BC6H. Supercompressed
+// KTX2 files, e.g., Basis Universal files, texture arrays, volume textures and
+// textures larger than 16384 in either dimension are not supported. Neither
+// are uncompressed pixels of a format and type unknown to PixelSize.
+func ParseKTX(data []byte) (*TextureData, error) {
+	switch {
+	case bytes.HasPrefix(data, ktx1Identifier):
+		return parseKTX1(data)
+	case bytes.HasPrefix(data, ktx2Identifier):
+		return parseKTX2(data)
+	}
+	return nil, errors.New("gogl: parsing KTX: not a KTX file")
+}
+
+// parseKTX1 parses a KTX file of version 1.
+func parseKTX1(data []byte) (*TextureData, error) {
+	if len(data) < ktx1HeaderSize {
+		return nil, errors.New("gogl: parsing KTX: truncated header")
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if order.Uint32(data[12:]) != 0x04030201 {
+		order = binary.BigEndian
+	}
+	field := func(offset int) int { return int(order.Uint32(data[offset:])) }
+	xtype, typeSize, format := GLEnum(field(16)), field(20), GLEnum(field(24))
+	internalformat := GLEnum(field(28))
+	width, height, depth := field(36), field(40), field(44)
+	arrayElements, faces, levels := field(48), field(52), field(56)
+	if order == binary.BigEndian && typeSize > 1 {
+		return nil, errors.New("gogl: parsing KTX: big-endian pixels are not supported")
+	}
+	if depth > 0 || arrayElements > 0 {
+		return nil, errors.New("gogl: parsing KTX: texture arrays and volume textures are not supported")
+	}
+	if faces != 1 && faces != 6 {
+		return nil, fmt.Errorf("gogl: parsing KTX: invalid number of faces %d", faces)
+	}
+	if levels == 0 {
+		levels = 1
+	}
+	if err := checkTextureDataSize(width, height, levels); err != nil {
+		return nil, fmt.Errorf("gogl: parsing KTX: %w", err)
+	}
+
+	texture := &TextureData{
+		Target:         GLTexture2D,
+		InternalFormat: internalformat,
+		Format:         format,
+		Type:           xtype,
+		Width:          width,
+		Height:         height,
+		Alignment:      4,
+	}
+	if faces == 6 {
+		texture.Target = GLTextureCubeMap
+	}
+	offset := ktx1HeaderSize + field(60)
+	for level := 0; level < levels; level++ {
+		if offset < 0 || offset > len(data)-4 {
+			return nil, errors.New("gogl: parsing KTX: truncated image data")
+		}
+		// imageSize is the size of a single face of cube maps.
+		size := field(offset)
+		offset += 4
+		for face := 0; face < faces; face++ {
+			if size < 0 || size > len(data)-offset {
+				return nil, errors.New("gogl: parsing KTX: truncated image data")
+			}
+			texture.Images = append(texture.Images, TextureImage{
+				Level:  level,
+				Face:   face,
+				Width:  mipmapSize(width, level),
+				Height: mipmapSize(height, level),
+				Data:   data[offset : offset+size],
+			})
+			offset += (size + 3) &^ 3
+		}
+	}
+	if err := texture.checkImages(); err != nil {
+		return nil, fmt.Errorf("gogl: parsing KTX: %w", err)
+	}
+	return texture, nil
+}
+
+// parseKTX2 parses a KTX file of version 2.
+func parseKTX2(data []byte) (*TextureData, error) {
+	if len(data) < ktx2HeaderSize {
+		return nil, errors.New("gogl: parsing KTX: truncated header")
+	}
+	le := binary.LittleEndian
+	vkFormat := le.Uint32(data[12:])
+	width, height, depth := int(le.Uint32(data[20:])), int(le.Uint32(data[24:])), int(le.Uint32(data[28:]))
+	layers, faces, levels := int(le.Uint32(data[32:])), int(le.Uint32(data[36:])), int(le.Uint32(data[40:]))
+	if scheme := le.Uint32(data[44:]); scheme != 0 {
+		return nil, fmt.Errorf("gogl: parsing KTX: supercompression scheme %d is not supported", scheme)
+	}
+	if depth > 0 || layers > 0 {
+		return nil, errors.New("gogl: parsing KTX: texture arrays and volume textures are not supported")
+	}
+	if faces != 1 && faces != 6 {
+		return nil, fmt.Errorf("gogl: parsing KTX: invalid number of faces %d", faces)
+	}
+	if levels == 0 {
+		levels = 1
+	}
+	if err := checkTextureDataSize(width, height, levels); err != nil {
+		return nil, fmt.Errorf("gogl: parsing KTX: %w", err)
+	}
+	texture := ktx2Format(vkFormat)
+	if texture == nil {
+		return nil, fmt.Errorf("gogl: parsing KTX: unsupported VkFormat %d", vkFormat)
+	}
+	texture.Target = GLTexture2D
+	if faces == 6 {
+		texture.Target = GLTextureCubeMap
+	}
+	texture.Width, texture.Height = width, height
+	texture.Alignment = 1
+
+	if ktx2HeaderSize+levels*ktx2LevelIndexSize > len(data) {
+		return nil, errors.New("gogl: parsing KTX: truncated level index")
+	}
+	for level := 0; level < levels; level++ {
+		index := data[ktx2HeaderSize+level*ktx2LevelIndexSize:]
+		offset, length := le.Uint64(index), le.Uint64(index[8:])
+		if offset > uint64(len(data)) || length > uint64(len(data))-offset || length%uint64(faces) != 0 {
+			return nil, errors.New("gogl: parsing KTX: truncated image data")
+		}
+		size := int(length) / faces
+		for face := 0; face < faces; face++ {
+			start := int(offset) + face*size
+			texture.Images = append(texture.Images, TextureImage{
+				Level:  level,
+				Face:   face,
+				Width:  mipmapSize(width, level),
+				Height: mipmapSize(height, level),
+				Data:   data[start : start+size],
+			})
+		}
+	}
+	if err := texture.checkImages(); err != nil {
+		return nil, fmt.Errorf("gogl: parsing KTX: %w", err)
+	}
+	return texture, nil
+}
+
+// ktx2Format returns a TextureData with the internal format, format and type
+// of a VkFormat of a KTX2 file, or nil if it is not supported.
+func ktx2Format(vkFormat uint32) *TextureData {
+	uncompressed := func(internalformat, format GLEnum) *TextureData {
+		return &TextureData{InternalFormat: internalformat, Format: format, Type: GLUInt8}
+	}
+	compressed := func(internalformat GLEnum) *TextureData {
+		return &TextureData{InternalFormat: internalformat}
+	}
+	switch vkFormat {
+	case 23: // VK_FORMAT_R8G8B8_UNORM
+		return uncompressed(GLRGB, GLRGB)
+	case 29: // VK_FORMAT_R8G8B8_SRGB
+		return uncompressed(GLSRGB8, GLRGB)
+	case 37: // VK_FORMAT_R8G8B8A8_UNORM
+		return uncompressed(GLRGBA, GLRGBA)
+	case 43: // VK_FORMAT_R8G8B8A8_SRGB
+		return uncompressed(GLSRGB8Alpha8, GLRGBA)
+	case 44: // VK_FORMAT_B8G8R8A8_UNORM
+		return uncompressed(GLRGBA, GLBGRA)
+	case 50: // VK_FORMAT_B8G8R8A8_SRGB
+		return uncompressed(GLSRGB8Alpha8, GLBGRA)
+	case 131: // VK_FORMAT_BC1_RGB_UNORM_BLOCK
+		return compressed(GLCompressedRGBS3TCDXT1)
+	case 132: // VK_FORMAT_BC1_RGB_SRGB_BLOCK
+		return compressed(GLCompressedSRGBS3TCDXT1)
+	case 133: // VK_FORMAT_BC1_RGBA_UNORM_BLOCK
+		return compressed(GLCompressedRGBAS3TCDXT1)
+	case 134: // VK_FORMAT_BC1_RGBA_SRGB_BLOCK
+		return compressed(GLCompressedSRGBAlphaS3TCDXT1)
+	case 135: // VK_FORMAT_BC2_UNORM_BLOCK
+		return compressed(GLCompressedRGBAS3TCDXT3)
+	case 136: // VK_FORMAT_BC2_SRGB_BLOCK
+		return compressed(GLCompressedSRGBAlphaS3TCDXT3)
+	case 137: // VK_FORMAT_BC3_UNORM_BLOCK
+		return compressed(GLCompressedRGBAS3TCDXT5)
+	case 138: // VK_FORMAT_BC3_SRGB_BLOCK
+		return compressed(GLCompressedSRGBAlphaS3TCDXT5)
+	case 139: // VK_FORMAT_BC4_UNORM_BLOCK
+		return compressed(GLCompressedRedRGTC1)
+	case 140: // VK_FORMAT_BC4_SNORM_BLOCK
+		return compressed(GLCompressedSignedRedRGTC1)
+	case 141: // VK_FORMAT_BC5_UNORM_BLOCK
+		return compressed(GLCompressedRGRGTC2)
+	case 142: // VK_FORMAT_BC5_SNORM_BLOCK
+		return compressed(GLCompressedSignedRGRGTC2)
+	case 145: // VK_FORMAT_BC7_UNORM_BLOCK
+		return compressed(GLCompressedRGBABPTCUnorm)
+	case 146: // VK_FORMAT_BC7_SRGB_BLOCK
+		return compressed(GLCompressedSRGBAlphaBPTCUnorm)
+	}
+	return nil
+}
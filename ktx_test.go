@@ -0,0 +1,166 @@
+package gogl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// ktx1File returns a little-endian KTX file of the given dimensions, faces and
+// images of RGBA pixels, with the imageSize of every level followed by its
+// faces.
+func ktx1File(width, height, faces uint32, levels [][]byte) []byte {
+	data := make([]byte, ktx1HeaderSize)
+	le := binary.LittleEndian
+	copy(data, ktx1Identifier)
+	le.PutUint32(data[12:], 0x04030201)
+	le.PutUint32(data[16:], uint32(GLUInt8))
+	le.PutUint32(data[20:], 1)
+	le.PutUint32(data[24:], uint32(GLRGBA))
+	le.PutUint32(data[28:], uint32(GLRGBA8))
+	le.PutUint32(data[36:], width)
+	le.PutUint32(data[40:], height)
+	le.PutUint32(data[52:], faces)
+	le.PutUint32(data[56:], uint32(len(levels)))
+	for _, image := range levels {
+		size := make([]byte, 4)
+		le.PutUint32(size, uint32(len(image)))
+		data = append(data, size...)
+		for face := uint32(0); face < faces; face++ {
+			data = append(data, image...)
+			for len(data)%4 != 0 {
+				data = append(data, 0)
+			}
+		}
+	}
+	return data
+}
+
+// ktx2File returns a KTX2 file of the given VkFormat and dimensions, whose
+// level index points at the images, which hold all faces of a level.
+func ktx2File(vkFormat, width, height, faces uint32, levels [][]byte) []byte {
+	data := make([]byte, ktx2HeaderSize+len(levels)*ktx2LevelIndexSize)
+	le := binary.LittleEndian
+	copy(data, ktx2Identifier)
+	le.PutUint32(data[12:], vkFormat)
+	le.PutUint32(data[20:], width)
+	le.PutUint32(data[24:], height)
+	le.PutUint32(data[36:], faces)
+	le.PutUint32(data[40:], uint32(len(levels)))
+	for i, image := range levels {
+		index := data[ktx2HeaderSize+i*ktx2LevelIndexSize:]
+		le.PutUint64(index, uint64(len(data)))
+		le.PutUint64(index[8:], uint64(len(image)))
+		le.PutUint64(index[16:], uint64(len(image)))
+		data = append(data, image...)
+	}
+	return data
+}
+
+// filled returns size bytes of value.
+func filled(size int, value byte) []byte {
+	return bytes.Repeat([]byte{value}, size)
+}
+
+func TestParseKTX1(t *testing.T) {
+	texture, err := ParseKTX(ktx1File(2, 2, 1, [][]byte{filled(16, 1), filled(4, 2)}))
+	if err != nil {
+		t.Fatalf("ParseKTX() error = %v", err)
+	}
+	if texture.Target != GLTexture2D || texture.InternalFormat != GLRGBA8 || texture.Format != GLRGBA || texture.Type != GLUInt8 || texture.Alignment != 4 || texture.Compressed() {
+		t.Errorf("ParseKTX() = %+v, want an uncompressed GL_TEXTURE_2D of GL_RGBA8 pixels", texture)
+	}
+	if len(texture.Images) != 2 {
+		t.Fatalf("ParseKTX() has %d images, want 2", len(texture.Images))
+	}
+	for i, image := range texture.Images {
+		size := 2 >> i
+		if image.Level != i || image.Width != size || image.Height != size || len(image.Data) != size*size*4 || image.Data[0] != byte(i+1) {
+			t.Errorf("Images[%d] = level %d, %dx%d, %d bytes, want level %d, %dx%d, %d bytes", i, image.Level, image.Width, image.Height, len(image.Data), i, size, size, size*size*4)
+		}
+	}
+}
+
+func TestParseKTX1CubeMap(t *testing.T) {
+	// The 3 bytes of every face of GL_RGB pixels are padded to 4.
+	data := ktx1File(1, 1, 6, [][]byte{filled(3, 7)})
+	binary.LittleEndian.PutUint32(data[24:], uint32(GLRGB))
+	texture, err := ParseKTX(data)
+	if err != nil {
+		t.Fatalf("ParseKTX() error = %v", err)
+	}
+	if texture.Target != GLTextureCubeMap || len(texture.Images) != 6 {
+		t.Fatalf("ParseKTX() = %v with %d images, want a GL_TEXTURE_CUBE_MAP with 6 images", texture.Target, len(texture.Images))
+	}
+	for i, image := range texture.Images {
+		if image.Face != i || string(image.Data) != "\x07\x07\x07" {
+			t.Errorf("Images[%d] = face %d, %q, want face %d, %q", i, image.Face, image.Data, i, "\x07\x07\x07")
+		}
+	}
+}
+
+func TestParseKTX2(t *testing.T) {
+	texture, err := ParseKTX(ktx2File(131, 8, 8, 1, [][]byte{filled(32, 1), filled(8, 2)}))
+	if err != nil {
+		t.Fatalf("ParseKTX() error = %v", err)
+	}
+	if texture.Target != GLTexture2D || texture.InternalFormat != GLCompressedRGBS3TCDXT1 || !texture.Compressed() || texture.Width != 8 || texture.Height != 8 {
+		t.Errorf("ParseKTX() = %+v, want a compressed 8x8 GL_TEXTURE_2D of GL_COMPRESSED_RGB_S3TC_DXT1_EXT", texture)
+	}
+	if len(texture.Images) != 2 || len(texture.Images[0].Data) != 32 || len(texture.Images[1].Data) != 8 || texture.Images[1].Data[0] != 2 || texture.Images[1].Width != 4 {
+		t.Errorf("ParseKTX() images = %+v, want a 8x8 level of 32 bytes and a 4x4 level of 8 bytes", texture.Images)
+	}
+
+	texture, err = ParseKTX(ktx2File(37, 1, 1, 6, [][]byte{filled(24, 3)}))
+	if err != nil {
+		t.Fatalf("ParseKTX() of a cube map error = %v", err)
+	}
+	if texture.Target != GLTextureCubeMap || texture.Format != GLRGBA || len(texture.Images) != 6 || len(texture.Images[5].Data) != 4 || texture.Images[5].Face != 5 {
+		t.Errorf("ParseKTX() of a cube map = %+v, want a GL_TEXTURE_CUBE_MAP of 6 GL_RGBA faces of 4 bytes", texture)
+	}
+}
+
+func TestParseKTXInvalid(t *testing.T) {
+	supercompressed := ktx2File(131, 4, 4, 1, [][]byte{filled(8, 0)})
+	binary.LittleEndian.PutUint32(supercompressed[44:], 1)
+	volume := ktx1File(4, 4, 1, [][]byte{filled(64, 0)})
+	binary.LittleEndian.PutUint32(volume[44:], 4)
+	overflowingOffset := ktx2File(131, 4, 4, 1, [][]byte{filled(8, 0)})
+	binary.LittleEndian.PutUint64(overflowingOffset[ktx2HeaderSize:], 1<<63)
+	halfFloat := ktx1File(1, 1, 1, [][]byte{filled(8, 0)})
+	binary.LittleEndian.PutUint32(halfFloat[16:], 0x140B)
+
+	tests := []struct {
+		name string
+		data []byte
+		err  string
+	}{
+		{"empty", nil, "not a KTX file"},
+		{"truncated KTX header", ktx1File(4, 4, 1, nil)[:40], "truncated header"},
+		{"truncated KTX2 header", ktx2File(37, 4, 4, 1, nil)[:40], "truncated header"},
+		{"faces", ktx1File(4, 4, 3, [][]byte{filled(64, 0)}), "invalid number of faces 3"},
+		{"volume", volume, "volume textures are not supported"},
+		{"truncated KTX image", ktx1File(4, 4, 1, [][]byte{filled(64, 0)})[:100], "truncated image data"},
+		{"missing KTX level", ktx1File(4, 4, 1, [][]byte{filled(64, 0), filled(16, 0)})[:ktx1HeaderSize+4+64], "truncated image data"},
+		{"too large", ktx1File(16385, 1, 1, [][]byte{filled(4, 0)}), "invalid size"},
+		{"too many levels", ktx1File(1, 1, 1, [][]byte{filled(4, 0), filled(4, 0)}), "mipmap levels exceed"},
+		{"supercompressed", supercompressed, "supercompression scheme 1 is not supported"},
+		{"unsupported VkFormat", ktx2File(1000, 4, 4, 1, [][]byte{filled(8, 0)}), "unsupported VkFormat 1000"},
+		{"truncated level index", ktx2File(131, 8, 8, 1, [][]byte{filled(32, 0), filled(8, 0)})[:ktx2HeaderSize+ktx2LevelIndexSize], "truncated level index"},
+		{"truncated KTX2 image", ktx2File(131, 8, 8, 1, [][]byte{filled(32, 0)})[:ktx2HeaderSize+ktx2LevelIndexSize+16], "truncated image data"},
+		{"overflowing offset", overflowingOffset, "truncated image data"},
+		{"uneven faces", ktx2File(37, 1, 1, 6, [][]byte{filled(23, 0)}), "truncated image data"},
+		{"short KTX level", ktx1File(2, 2, 1, [][]byte{filled(16, 0), filled(3, 0)}), "level 1, face 0: 3 bytes are too few for 1x1 pixels, which need 4"},
+		{"short KTX2 level", ktx2File(37, 2, 2, 1, [][]byte{filled(12, 0)}), "level 0, face 0: 12 bytes are too few for 2x2 pixels, which need 16"},
+		{"unknown type", halfFloat, "unsupported format GL_RGBA and type"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			texture, err := ParseKTX(test.data)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("ParseKTX() = %v, %v, want error containing %q", texture, err, test.err)
+			}
+		})
+	}
+}
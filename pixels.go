@@ -0,0 +1,70 @@
+package gogl
+
+import "fmt"
+
+// PixelSize returns the size in bytes of a single pixel of the given format
+// and type, as passed to TexImage2D or ReadPixels, or 0 if either is not
+// supported by this package.
+func PixelSize(format, xtype GLEnum) int {
+	switch xtype {
+	case GLUInt164444, GLUInt165551, GLUInt16565:
+		return 2
+	case GLUInt8888Rev, GLUInt248:
+		return 4
+	case GLFloat32UInt248Rev:
+		return 8
+	}
+	var components int
+	switch format {
+	case GLAlpha, GLLuminance, GLDepthComponent, GLRed:
+		components = 1
+	case GLLuminanceAlpha, GLRG:
+		components = 2
+	case GLRGB:
+		components = 3
+	case GLRGBA, GLBGRA:
+		components = 4
+	}
+	switch xtype {
+	case GLInt8, GLUInt8:
+		return components
+	case GLInt16, GLUInt16:
+		return components * 2
+	case GLInt32, GLUInt32, GLFloat32:
+		return components * 4
+	}
+	return 0
+}
+
+// unpackAlignment is the GLUnpackAlignment of the context, which is queried by
+// InitWithBackend and updated by PixelStorei, so that uploads do not need to
+// query it every time.
+var unpackAlignment int32 = 4
+
+// imageLayout returns the size in bytes of a row of pixels of the given width,
+// format and type, and the stride between the starts of rows aligned to
+// alignment bytes, as set with GLPackAlignment or GLUnpackAlignment. It panics
+// if the format or type is not supported.
+func imageLayout(function string, width int32, format, xtype GLEnum, alignment int32) (row, stride int) {
+	size := PixelSize(format, xtype)
+	if size == 0 {
+		panic(fmt.Sprintf("gogl: %s: unsupported format %v and type %v", function, format, xtype))
+	}
+	if alignment < 1 {
+		alignment = 1
+	}
+	row = int(width) * size
+	stride = (row + int(alignment) - 1) / int(alignment) * int(alignment)
+	return row, stride
+}
+
+// imageDataSize returns the number of bytes OpenGL reads or writes for an
+// image of the given dimensions, format and type, whose rows are aligned to
+// alignment bytes. The last row is not padded.
+func imageDataSize(function string, width, height int32, format, xtype GLEnum, alignment int32) int {
+	if width <= 0 || height <= 0 {
+		return 0
+	}
+	row, stride := imageLayout(function, width, format, xtype, alignment)
+	return (int(height)-1)*stride + row
+}
@@ -0,0 +1,148 @@
+package gogl
+
+import (
+	"fmt"
+	"log"
+)
+
+// UniformError is reported by the name-based uniform setters of Program, such
+// as SetVec3, if the Program has no active uniform of the given name. This
+// happens for misspelled names, and for uniforms the driver optimised out
+// because they do not contribute to the output of the shaders.
+type UniformError struct {
+	// Program is the Program the uniform was looked up in.
+	Program Program
+	// Name is the name of the uniform.
+	Name string
+}
+
+// Error returns the error formatted as
+// "gogl: uniform "lightDir" is not active in program 3".
+func (err *UniformError) Error() string {
+	return fmt.Sprintf("gogl: uniform %q is not active in program %d", err.Name, err.Program)
+}
+
+// LogUniformError is the default handler of SetUniformErrorHandler. It logs
+// the error with the standard logger.
+func LogUniformError(err *UniformError) {
+	log.Print(err)
+}
+
+// uniformErrorHandler is the handler set with SetUniformErrorHandler.
+var uniformErrorHandler = LogUniformError
+
+// SetUniformErrorHandler sets the handler the name-based uniform setters of
+// Program pass a *UniformError to when a uniform is not active. Every missing
+// uniform is reported only once per Program. A nil handler ignores missing
+// uniforms.
+func SetUniformErrorHandler(handler func(err *UniformError)) {
+	uniformErrorHandler = handler
+}
+
+// uniformLocations caches the uniform locations of every Program used with the
+// name-based uniform setters. Missing uniforms are cached as -1, so they are
+// looked up and reported only once.
+var uniformLocations = map[Program]map[string]UniformLocation{}
+
+// currentProgram is the Program last made current with Use, so that the
+// name-based uniform setters can make their Program current without querying
+// GL_CURRENT_PROGRAM.
+var currentProgram Program
+
+// uniformLocation returns the location of the named uniform, looking it up on
+// first use only. If the uniform is active, the Program is made current, so
+// that it can be set with the Uniform functions.
+func (program Program) uniformLocation(name string) UniformLocation {
+	locations, ok := uniformLocations[program]
+	if !ok {
+		locations = map[string]UniformLocation{}
+		uniformLocations[program] = locations
+	}
+	location, ok := locations[name]
+	if !ok {
+		location = backend.GetUniformLocation(program, name)
+		locations[name] = location
+		if location < 0 && uniformErrorHandler != nil {
+			uniformErrorHandler(&UniformError{Program: program, Name: name})
+		}
+	}
+	if location >= 0 && program != currentProgram {
+		program.Use()
+	}
+	return location
+}
+
+// forgetUniformLocations removes the cached uniform locations of the Program,
+// which become invalid when it is linked again or deleted.
+func (program Program) forgetUniformLocations() {
+	delete(uniformLocations, program)
+}
+
+// The name-based uniform setters below set uniforms of the Program by name.
+// The location of every name is looked up once and cached until the Program
+// is linked again or deleted. Uniforms that are not active are reported to
+// the handler set with SetUniformErrorHandler, and are not written.
+//
+// Unlike the Uniform functions, they set the uniforms of their own Program, not
+// of the Program in use: if it is not current, it is made current with Use
+// first, and stays current afterwards.
+//
+// Vectors and matrices are passed as arrays, so that the types of common math
+// libraries, e.g., mgl32.Vec3 and mgl32.Mat4, can be passed directly. Matrices
+// are expected in column-major order.
+
+// SetFloat sets the float uniform of the given name.
+func (program Program) SetFloat(name string, v float32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.Uniform1f(location, v)
+	}
+}
+
+// SetInt sets the int, bool or sampler uniform of the given name.
+func (program Program) SetInt(name string, v int32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.Uniform1i(location, v)
+	}
+}
+
+// SetVec2 sets the vec2 uniform of the given name.
+func (program Program) SetVec2(name string, v [2]float32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.Uniform2f(location, v[0], v[1])
+	}
+}
+
+// SetVec3 sets the vec3 uniform of the given name.
+func (program Program) SetVec3(name string, v [3]float32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.Uniform3f(location, v[0], v[1], v[2])
+	}
+}
+
+// SetVec4 sets the vec4 uniform of the given name.
+func (program Program) SetVec4(name string, v [4]float32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.Uniform4f(location, v[0], v[1], v[2], v[3])
+	}
+}
+
+// SetMat2 sets the mat2 uniform of the given name.
+func (program Program) SetMat2(name string, m [4]float32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.UniformMatrix2fv(location, 1, false, m[:])
+	}
+}
+
+// SetMat3 sets the mat3 uniform of the given name.
+func (program Program) SetMat3(name string, m [9]float32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.UniformMatrix3fv(location, 1, false, m[:])
+	}
+}
+
+// SetMat4 sets the mat4 uniform of the given name.
+func (program Program) SetMat4(name string, m [16]float32) {
+	if location := program.uniformLocation(name); location >= 0 {
+		backend.UniformMatrix4fv(location, 1, false, m[:])
+	}
+}
@@ -0,0 +1,124 @@
+package gogl_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/gogltest"
+)
+
+// newUniformProgram creates a Program declaring a float uniform "u_time" and a
+// mat2 uniform "u_rotation", and records the UniformErrors it reports.
+func newUniformProgram(t *testing.T, b *gogltest.Backend) (gogl.Program, *[]gogl.UniformError) {
+	t.Helper()
+	program := gogl.CreateProgram()
+	b.SetActiveUniforms(program,
+		gogl.ActiveUniform{Name: "u_time", Type: gogl.GLFloat32, Size: 1, Location: 3},
+		gogl.ActiveUniform{Name: "u_rotation", Type: gogl.GLFloatMat2, Size: 1, Location: 7},
+	)
+	var errs []gogl.UniformError
+	gogl.SetUniformErrorHandler(func(err *gogl.UniformError) {
+		errs = append(errs, *err)
+	})
+	t.Cleanup(func() {
+		gogl.SetUniformErrorHandler(gogl.LogUniformError)
+	})
+	b.ClearCalls()
+	return program, &errs
+}
+
+func TestProgramSetters(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	program, errs := newUniformProgram(t, b)
+
+	program.SetFloat("u_time", 1.5)
+	program.SetMat2("u_rotation", [4]float32{0, 1, -1, 0})
+	program.SetFloat("u_time", 2.5)
+
+	want := []string{
+		fmt.Sprintf("UseProgram(%d)", program),
+		"Uniform1f(3, 1.5)",
+		"UniformMatrix2fv(7, 1, false, [0 1 -1 0])",
+		"Uniform1f(3, 2.5)",
+	}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+	if got := len(b.CallsTo("GetUniformLocation")); got != 2 {
+		t.Errorf("GetUniformLocation called %d times, want 2", got)
+	}
+	if len(*errs) != 0 {
+		t.Errorf("UniformErrors = %+v, want none", *errs)
+	}
+}
+
+func TestProgramSettersUseTheirProgram(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	program, _ := newUniformProgram(t, b)
+	other := gogl.CreateProgram()
+	other.Use()
+
+	program.SetFloat("u_time", 1)
+	if got := b.CurrentProgram(); got != program {
+		t.Errorf("CurrentProgram() = %d, want %d", got, program)
+	}
+
+	program.Delete()
+	program, _ = newUniformProgram(t, b)
+	program.SetFloat("u_time", 1)
+	if got := len(b.CallsTo("UseProgram")); got != 1 {
+		t.Errorf("UseProgram called %d times after Delete, want 1", got)
+	}
+}
+
+func TestProgramSettersForgetLocations(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	program, _ := newUniformProgram(t, b)
+
+	program.SetFloat("u_time", 1)
+	program.Link()
+	b.SetActiveUniforms(program,
+		gogl.ActiveUniform{Name: "u_time", Type: gogl.GLFloat32, Size: 1, Location: 5},
+	)
+	b.ClearCalls()
+	program.SetFloat("u_time", 1)
+
+	want := []string{"Uniform1f(5, 1)"}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls after Link = %q, want %q", got, want)
+	}
+	if got := len(b.CallsTo("GetUniformLocation")); got != 1 {
+		t.Errorf("GetUniformLocation called %d times after Link, want 1", got)
+	}
+}
+
+func TestProgramSettersReportMissingUniformsOnce(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	program, errs := newUniformProgram(t, b)
+
+	program.SetVec3("u_light", [3]float32{1, 2, 3})
+	program.SetVec3("u_light", [3]float32{1, 2, 3})
+	program.SetInt("u_texture", 0)
+
+	want := []gogl.UniformError{
+		{Program: program, Name: "u_light"},
+		{Program: program, Name: "u_texture"},
+	}
+	if !reflect.DeepEqual(*errs, want) {
+		t.Errorf("UniformErrors = %+v, want %+v", *errs, want)
+	}
+	if got := callStrings(b.Calls); len(got) != 0 {
+		t.Errorf("calls = %q, want none", got)
+	}
+	if got, want := want[0].Error(), fmt.Sprintf("gogl: uniform \"u_light\" is not active in program %d", program); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	program.Delete()
+	program.SetVec3("u_light", [3]float32{1, 2, 3})
+	if got := len(*errs); got != 3 {
+		t.Errorf("got %d UniformErrors after Delete, want 3", got)
+	}
+}
@@ -1,42 +1,42 @@
 package gogl
 
-import (
-	"strings"
-
-	"github.com/go-gl/gl/v2.1/gl"
-)
+import "github.com/go-gl/gl/v2.1/gl"
 
 // AttachShader attaches either a fragment or vertex Shader to the Program.
 func (program Program) AttachShader(shader Shader) {
-	gl.AttachShader(uint32(program), uint32(shader))
+	backend.AttachShader(program, shader)
 }
 
 // BindAttribLocation binds a generic vertex index to an attribute variable.
 func BindAttribLocation(program Program, index uint32, name string) {
-	gl.BindAttribLocation(uint32(program), index, gl.Str(name+"\x00"))
+	backend.BindAttribLocation(program, index, name)
 }
 
 // Compile compiles the GLSL shader into binary data so that it can be used by a
 // Program.
 func (shader Shader) Compile() {
-	gl.CompileShader(uint32(shader))
+	backend.CompileShader(shader)
 }
 
 // CreateProgram creates and initializes a Program object.
 func CreateProgram() Program {
-	return Program(gl.CreateProgram())
+	return backend.CreateProgram()
 }
 
 // CreateShader creates a Shader that can then be configured further using
 // ShaderSource and CompileShader.
 func CreateShader(xtype GLEnum) Shader {
-	return Shader(gl.CreateShader(uint32(xtype)))
+	return backend.CreateShader(xtype)
 }
 
 // Delete deletes the Program object. This method has no effect if the program
 // has already been deleted.
 func (program Program) Delete() {
-	gl.DeleteProgram(uint32(program))
+	program.forgetUniformLocations()
+	if program == currentProgram {
+		currentProgram = 0
+	}
+	backend.DeleteProgram(program)
 }
 
 // Delete marks the Shader object for deletion. It will then be deleted whenever
@@ -44,12 +44,12 @@ func (program Program) Delete() {
 // already been deleted, and the Shader is automatically marked for deletion
 // when it is destroyed by the garbage collector.
 func (shader Shader) Delete() {
-	gl.DeleteShader(uint32(shader))
+	backend.DeleteShader(shader)
 }
 
 // DetachShader detaches a previously attached Shader from the Program.
 func (program Program) DetachShader(shader Shader) {
-	gl.DetachShader(uint32(program), uint32(shader))
+	backend.DetachShader(program, shader)
 }
 
 // TODO: GetAttachedShaders
@@ -57,129 +57,107 @@ func (program Program) DetachShader(shader Shader) {
 // GetDeleteStatus returns a bool indicating whether or not the program is
 // flagged for deletion.
 func (program Program) GetDeleteStatus() bool {
-	var params int32
-	gl.GetProgramiv(uint32(program), gl.DELETE_STATUS, &params)
-	return params == gl.TRUE
+	return backend.GetProgrami(program, gl.DELETE_STATUS) == gl.TRUE
 }
 
 // GetLinkStatus returns a bool indicating whether or not the last link
 // operation was successful.
 func (program Program) GetLinkStatus() bool {
-	var params int32
-	gl.GetProgramiv(uint32(program), gl.LINK_STATUS, &params)
-	return params == gl.TRUE
+	return backend.GetProgrami(program, gl.LINK_STATUS) == gl.TRUE
 }
 
 // GetValidateStatus returns a bool indicating whether or not the last
 // validation operation was successful.
 func (program Program) GetValidateStatus() bool {
-	var params int32
-	gl.GetProgramiv(uint32(program), gl.VALIDATE_STATUS, &params)
-	return params == gl.TRUE
+	return backend.GetProgrami(program, gl.VALIDATE_STATUS) == gl.TRUE
 }
 
 // GetAttachedShaders returns an int32 indicating the number of attached shaders
 // to a program.
 func (program Program) GetAttachedShaders() int32 {
-	var params int32
-	gl.GetProgramiv(uint32(program), gl.ATTACHED_SHADERS, &params)
-	return params
+	return backend.GetProgrami(program, gl.ATTACHED_SHADERS)
 }
 
 // GetActiveAttributes returns an int32 indicating the number of active
 // attribute variables to a program.
 func (program Program) GetActiveAttributes() int32 {
-	var params int32
-	gl.GetProgramiv(uint32(program), gl.ACTIVE_ATTRIBUTES, &params)
-	return params
+	return backend.GetProgrami(program, gl.ACTIVE_ATTRIBUTES)
 }
 
 // GetActiveUniforms returns an int32 indicating the number of active uniform
 // variables to a program.
 func (program Program) GetActiveUniforms() int32 {
-	var params int32
-	gl.GetProgramiv(uint32(program), gl.ACTIVE_UNIFORMS, &params)
-	return params
+	return backend.GetProgrami(program, gl.ACTIVE_UNIFORMS)
 }
 
 // GetInfoLog returns the information log for the Program object. It contains
 // errors that occurred during failed linking or validation of Program objects.
+//
+// Trailing NUL bytes and whitespace are removed from the log.
 func (program Program) GetInfoLog() string {
-	var bufSize int32
-	gl.GetProgramiv(uint32(program), gl.INFO_LOG_LENGTH, &bufSize)
-	infoLog := strings.Repeat("\x00", int(bufSize+1))
-	gl.GetProgramInfoLog(uint32(program), bufSize, nil, gl.Str(infoLog))
-	return infoLog
+	return trimInfoLog(backend.GetProgramInfoLog(program))
 }
 
 // GetDeleteStatus returns a bool indicating whether or not the shader is
 // flagged for deletion.
 func (shader Shader) GetDeleteStatus() bool {
-	var params int32
-	gl.GetShaderiv(uint32(shader), gl.DELETE_STATUS, &params)
-	return params == gl.TRUE
+	return backend.GetShaderi(shader, gl.DELETE_STATUS) == gl.TRUE
 }
 
 // GetCompileStatus returns a bool indicating whether or not the last shader
 // compilation was successful.
 func (shader Shader) GetCompileStatus() bool {
-	var params int32
-	gl.GetShaderiv(uint32(shader), gl.COMPILE_STATUS, &params)
-	return params == gl.TRUE
+	return backend.GetShaderi(shader, gl.COMPILE_STATUS) == gl.TRUE
 }
 
 // GetShaderType returns a GLEnum indicating whether the shader is a vertex
 // shader (GLVertexShader) or fragment shader (GLFragmentShader) object.
 func (shader Shader) GetShaderType() GLEnum {
-	var params int32
-	gl.GetShaderiv(uint32(shader), gl.SHADER_TYPE, &params)
-	return GLEnum(params)
+	return GLEnum(backend.GetShaderi(shader, gl.SHADER_TYPE))
 }
 
 // TODO: GetShaderPrecisionFormat
 
 // GetInfoLog returns the information log for the Shader object. It contains
 // warnings, debugging and compile information.
+//
+// Trailing NUL bytes and whitespace are removed from the log.
 func (shader Shader) GetInfoLog() string {
-	var bufSize int32
-	gl.GetShaderiv(uint32(shader), gl.INFO_LOG_LENGTH, &bufSize)
-	infoLog := strings.Repeat("\x00", int(bufSize+1))
-	gl.GetShaderInfoLog(uint32(shader), bufSize, nil, gl.Str(infoLog))
-	return infoLog
+	return trimInfoLog(backend.GetShaderInfoLog(shader))
 }
 
 // TODO: GetShaderSource
 
 // IsProgram returns true if the Program is valid, false otherwise.
 func (program Program) IsProgram() bool {
-	return gl.IsProgram(uint32(program))
+	return backend.IsProgram(program)
 }
 
 // IsShader returns true if the Shader is valid, false otherwise.
 func (shader Shader) IsShader() bool {
-	return gl.IsShader(uint32(shader))
+	return backend.IsShader(shader)
 }
 
 // Link links the Program, completing the process of preparing the GPU code for
 // the program's fragment and vertex shaders.
 func (program Program) Link() {
-	gl.LinkProgram(uint32(program))
+	program.forgetUniformLocations()
+	backend.LinkProgram(program)
 }
 
 // Source sets the source code of the Shader.
 func (shader Shader) Source(source string) {
-	cstrs, free := gl.Strs(source + "\x00")
-	gl.ShaderSource(uint32(shader), 1, cstrs, nil)
-	free()
+	backend.ShaderSource(shader, source)
 }
 
 // Use sets the Program as part of the current rendering state.
 func (program Program) Use() {
-	gl.UseProgram(uint32(program))
+	backend.UseProgram(program)
+	currentProgram = program
 }
 
 // Validate validates the Program. It checks if it is successfully linked and if
 // it can be used in the current OpenGL state.
 func (program Program) Validate() {
-	gl.ValidateProgram(uint32(program))
+	backend.ValidateProgram(program)
 }
@@ -0,0 +1,81 @@
+package gogl
+
+import (
+	"fmt"
+	"image"
+	"unsafe"
+)
+
+// ReadPixelsRGBA reads a block of pixels of the given dimensions, whose lower
+// left corner is at x, y, from the current framebuffer into an *image.RGBA.
+// The rows are flipped, so that the image is in top-down order.
+//
+// The color values are stored as they are read, so the framebuffer is
+// expected to hold colors with premultiplied alpha, or to be opaque. Use
+// ReadPixelsNRGBA for framebuffers holding colors with non-premultiplied
+// alpha.
+func ReadPixelsRGBA(x, y, width, height int32) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	readPixelsTopDown("ReadPixelsRGBA", x, y, width, height, img.Pix)
+	return img
+}
+
+// ReadPixelsNRGBA is like ReadPixelsRGBA, but returns an *image.NRGBA for
+// framebuffers holding colors with non-premultiplied alpha.
+func ReadPixelsNRGBA(x, y, width, height int32) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, int(width), int(height)))
+	readPixelsTopDown("ReadPixelsNRGBA", x, y, width, height, img.Pix)
+	return img
+}
+
+// ReadPixelsImplementationFormat reads a block of pixels like ReadPixels, in
+// the format and type preferred by the implementation, as returned by
+// GetImplementationColorReadFormat and GetImplementationColorReadType. The
+// pixels are returned in bottom-up order, with rows aligned to
+// GetPackAlignment bytes.
+//
+// An error is returned without reading if the size of the pixels of the
+// format and type is not known to PixelSize, e.g., for GL_HALF_FLOAT.
+func ReadPixelsImplementationFormat(x, y, width, height int32) (pixels []byte, format, xtype GLEnum, err error) {
+	format = GetImplementationColorReadFormat()
+	xtype = GetImplementationColorReadType()
+	if PixelSize(format, xtype) == 0 {
+		return nil, format, xtype, fmt.Errorf("gogl: reading pixels: unsupported implementation format %v and type %v", format, xtype)
+	}
+	pixels = make([]byte, imageDataSize("ReadPixelsImplementationFormat", width, height, format, xtype, GetPackAlignment()))
+	ReadPixels(x, y, width, height, format, xtype, pixels)
+	return pixels, format, xtype, nil
+}
+
+// readPixelsTopDown reads a block of pixels in GLRGBA and GLUInt8 into pix,
+// which holds tightly packed rows in top-down order.
+func readPixelsTopDown(function string, x, y, width, height int32, pix []byte) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	alignment := GetPackAlignment()
+	row, stride := imageLayout(function, width, GLRGBA, GLUInt8, alignment)
+	data := pix
+	if stride != row {
+		data = make([]byte, imageDataSize(function, width, height, GLRGBA, GLUInt8, alignment))
+	}
+	backend.ReadPixels(x, y, width, height, GLRGBA, GLUInt8, unsafe.Pointer(&data[0]))
+
+	if stride != row {
+		for i := 0; i < int(height); i++ {
+			copy(pix[(int(height)-1-i)*row:], data[i*stride:i*stride+row])
+		}
+		return
+	}
+	flipRows(pix, row, int(height))
+}
+
+// flipRows reverses the order of the rows of pixels, each row bytes long.
+func flipRows(pixels []byte, row, height int) {
+	tmp := make([]byte, row)
+	for top, bottom := 0, height-1; top < bottom; top, bottom = top+1, bottom-1 {
+		copy(tmp, pixels[top*row:(top+1)*row])
+		copy(pixels[top*row:(top+1)*row], pixels[bottom*row:(bottom+1)*row])
+		copy(pixels[bottom*row:(bottom+1)*row], tmp)
+	}
+}
@@ -0,0 +1,111 @@
+package gogl_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/gogltest"
+)
+
+// fillRows makes the fake Backend read pixels whose bytes hold the index of
+// their row, counted from the bottom, and 0xFF in the row padding.
+func fillRows(b *gogltest.Backend) {
+	b.ReadPixelsFunc = func(x, y, width, height int32, format, xtype gogl.GLEnum, pixels []byte) {
+		row := int(width) * gogl.PixelSize(format, xtype)
+		stride := len(pixels) / int(height)
+		if int(height) > 1 {
+			stride = (len(pixels) - row) / (int(height) - 1)
+		}
+		for i := range pixels {
+			if i%stride < row {
+				pixels[i] = byte(i / stride)
+			} else {
+				pixels[i] = 0xFF
+			}
+		}
+	}
+}
+
+func TestReadPixelsRGBA(t *testing.T) {
+	for _, alignment := range []int32{1, 4, 8} {
+		b := initBackend(t, "3.3.0", "")
+		b.Integers[gogl.GLPackAlignment] = []int32{alignment}
+		fillRows(b)
+
+		img := gogl.ReadPixelsRGBA(1, 2, 3, 3)
+		nimg := gogl.ReadPixelsNRGBA(1, 2, 3, 3)
+
+		for y := 0; y < 3; y++ {
+			want := make([]byte, 12)
+			for i := range want {
+				want[i] = byte(2 - y)
+			}
+			if got := img.Pix[y*img.Stride : (y+1)*img.Stride]; !reflect.DeepEqual(got, want) {
+				t.Errorf("alignment %d: ReadPixelsRGBA row %d = %v, want %v", alignment, y, got, want)
+			}
+			if got := nimg.Pix[y*nimg.Stride : (y+1)*nimg.Stride]; !reflect.DeepEqual(got, want) {
+				t.Errorf("alignment %d: ReadPixelsNRGBA row %d = %v, want %v", alignment, y, got, want)
+			}
+		}
+		want := []string{
+			"ReadPixels(1, 2, 3, 3, GL_RGBA, GL_UNSIGNED_BYTE)",
+			"ReadPixels(1, 2, 3, 3, GL_RGBA, GL_UNSIGNED_BYTE)",
+		}
+		if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+			t.Errorf("alignment %d: calls = %q, want %q", alignment, got, want)
+		}
+	}
+}
+
+func TestReadPixelsRGBAEmpty(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	if img := gogl.ReadPixelsRGBA(0, 0, 0, 4); len(img.Pix) != 0 {
+		t.Errorf("ReadPixelsRGBA() = %d bytes, want none", len(img.Pix))
+	}
+	if got := callStrings(b.Calls); len(got) != 0 {
+		t.Errorf("calls = %q, want none", got)
+	}
+}
+
+func TestReadPixelsImplementationFormat(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	b.Integers[gogl.GLImplementationColorReadFormat] = []int32{int32(gogl.GLRGB)}
+	b.Integers[gogl.GLImplementationColorReadType] = []int32{int32(gogl.GLUInt8)}
+	fillRows(b)
+
+	pixels, format, xtype, err := gogl.ReadPixelsImplementationFormat(0, 0, 3, 2)
+	if err != nil {
+		t.Fatalf("ReadPixelsImplementationFormat() error = %v", err)
+	}
+	if format != gogl.GLRGB || xtype != gogl.GLUInt8 {
+		t.Errorf("format, type = %v, %v, want GL_RGB, GL_UNSIGNED_BYTE", format, xtype)
+	}
+	// The rows of 9 bytes are padded to the pack alignment of 4, except for
+	// the last one, and stay in bottom-up order.
+	want := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0xFF, 0xFF, 0xFF, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	if !reflect.DeepEqual(pixels, want) {
+		t.Errorf("pixels = %v, want %v", pixels, want)
+	}
+}
+
+func TestReadPixelsImplementationFormatUnsupported(t *testing.T) {
+	// GL_HALF_FLOAT and GL_UNSIGNED_INT_2_10_10_10_REV.
+	for _, xtype := range []int32{0x140B, 0x8368} {
+		b := initBackend(t, "3.3.0", "")
+		b.Integers[gogl.GLImplementationColorReadFormat] = []int32{int32(gogl.GLRGBA)}
+		b.Integers[gogl.GLImplementationColorReadType] = []int32{xtype}
+
+		pixels, _, got, err := gogl.ReadPixelsImplementationFormat(0, 0, 4, 4)
+		if want := fmt.Sprintf("gogl: reading pixels: unsupported implementation format GL_RGBA and type %v", gogl.GLEnum(xtype)); err == nil || err.Error() != want || pixels != nil {
+			t.Errorf("ReadPixelsImplementationFormat() with type %#x = %v, %v, want error %q", xtype, pixels, err, want)
+		}
+		if got != gogl.GLEnum(xtype) {
+			t.Errorf("type = %v, want %#x", got, xtype)
+		}
+		if calls := b.CallsTo("ReadPixels"); len(calls) != 0 {
+			t.Errorf("ReadPixels called %d times, want 0", len(calls))
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package gogl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RenderTargetSpec describes the size and the attachments of a RenderTarget.
+type RenderTargetSpec struct {
+	// Width and Height are the size of every attachment.
+	Width, Height int32
+	// ColorFormats are the internal formats of the color attachments, e.g.,
+	// GLRGBA8 or GLRGBA16F. The color attachment i is attached to
+	// GLColorAttachment0 + i, and the fragment shader output i is written to
+	// it. There may be up to GetMaxDrawBuffers and GetMaxColorAttachments
+	// color attachments.
+	ColorFormats []GLEnum
+	// DepthStencilFormat is the internal format of the depth or stencil
+	// attachment, e.g., GLDepthComponent24, GLDepth24Stencil8 or
+	// GLStencilIndex8, or 0 if there is none.
+	DepthStencilFormat GLEnum
+	// ColorRenderbuffers specifies whether the colors are stored in
+	// Renderbuffers instead of Textures, which is enough if they are only read
+	// with ReadPixels.
+	ColorRenderbuffers bool
+	// DepthStencilTexture specifies whether the depth or stencil attachment is
+	// stored in a Texture instead of a Renderbuffer, e.g., to sample the depth
+	// in a later pass.
+	DepthStencilTexture bool
+	// Samples is the number of samples of multisampled attachments, up to
+	// GetMaxSamples, or 0 for attachments that are not multisampled.
+	// Multisampled attachments must be Renderbuffers, and are resolved into
+	// another RenderTarget with Resolve.
+	Samples int32
+}
+
+// RenderTarget is a Framebuffer with the Textures and Renderbuffers attached to
+// it, for rendering offscreen.
+type RenderTarget struct {
+	// Spec is the spec the RenderTarget was created from, with the size it
+	// was last resized to.
+	Spec        RenderTargetSpec
+	Framebuffer Framebuffer
+	// ColorTextures or ColorRenderbuffers hold the color attachments in the
+	// order of Spec.ColorFormats, depending on Spec.ColorRenderbuffers.
+	ColorTextures      []Texture
+	ColorRenderbuffers []Renderbuffer
+	// DepthStencilTexture or DepthStencilRenderbuffer is the depth or stencil
+	// attachment, depending on Spec.DepthStencilTexture, or 0 if there is none.
+	DepthStencilTexture      Texture
+	DepthStencilRenderbuffer Renderbuffer
+}
+
+// NewRenderTarget creates a Framebuffer and the Textures and Renderbuffers of
+// the spec, and attaches them. The Framebuffer is left bound to
+// GLFramebuffer. The Textures are allocated without contents, filter
+// linearly and clamp their texture coordinates to the edges.
+//
+// NewRenderTarget deletes everything it created and returns the
+// *FramebufferError of ValidateFramebuffer if the Framebuffer is not complete,
+// e.g., because a format cannot be rendered to.
+func NewRenderTarget(spec RenderTargetSpec) (*RenderTarget, error) {
+	if spec.Width <= 0 || spec.Height <= 0 {
+		return nil, fmt.Errorf("gogl: render target size %dx%d is empty", spec.Width, spec.Height)
+	}
+	if spec.Samples > 0 {
+		if (len(spec.ColorFormats) > 0 && !spec.ColorRenderbuffers) || spec.DepthStencilTexture {
+			return nil, errors.New("gogl: multisampled render target attachments must be renderbuffers")
+		}
+		if max := GetMaxSamples(); spec.Samples > max {
+			return nil, fmt.Errorf("gogl: %d samples exceed the maximum of %d", spec.Samples, max)
+		}
+	}
+	if len(spec.ColorFormats) > 1 {
+		if max := GetMaxDrawBuffers(); len(spec.ColorFormats) > int(max) {
+			return nil, fmt.Errorf("gogl: %d color attachments exceed the maximum of %d draw buffers", len(spec.ColorFormats), max)
+		}
+		if max := GetMaxColorAttachments(); len(spec.ColorFormats) > int(max) {
+			return nil, fmt.Errorf("gogl: %d color attachments exceed the maximum of %d", len(spec.ColorFormats), max)
+		}
+	}
+	target := &RenderTarget{Spec: spec, Framebuffer: CreateFramebuffer()}
+	for range spec.ColorFormats {
+		if spec.ColorRenderbuffers {
+			target.ColorRenderbuffers = append(target.ColorRenderbuffers, CreateRenderbuffer())
+		} else {
+			target.ColorTextures = append(target.ColorTextures, createRenderTexture())
+		}
+	}
+	if spec.DepthStencilFormat != 0 {
+		if spec.DepthStencilTexture {
+			target.DepthStencilTexture = createRenderTexture()
+		} else {
+			target.DepthStencilRenderbuffer = CreateRenderbuffer()
+		}
+	}
+	// Renderbuffers can only be attached once they have been bound, which
+	// allocating them does.
+	target.allocate()
+
+	BindFramebuffer(GLFramebuffer, target.Framebuffer)
+	attachments := make([]GLEnum, len(spec.ColorFormats))
+	for i := range spec.ColorFormats {
+		attachments[i] = GLColorAttachment0 + GLEnum(i)
+		if spec.ColorRenderbuffers {
+			FramebufferRenderbuffer(GLFramebuffer, attachments[i], GLRenderbuffer, target.ColorRenderbuffers[i])
+		} else {
+			FramebufferTexture2D(GLFramebuffer, attachments[i], GLTexture2D, target.ColorTextures[i], 0)
+		}
+	}
+	// The draw and read buffers are part of the state of the Framebuffer.
+	switch {
+	case len(attachments) == 0:
+		DrawBuffer(GLNone)
+		ReadBuffer(GLNone)
+	case len(attachments) > 1:
+		DrawBuffers(attachments)
+	}
+	if spec.DepthStencilFormat != 0 {
+		attachment := depthStencilAttachment(spec.DepthStencilFormat)
+		if spec.DepthStencilTexture {
+			FramebufferTexture2D(GLFramebuffer, attachment, GLTexture2D, target.DepthStencilTexture, 0)
+		} else {
+			FramebufferRenderbuffer(GLFramebuffer, attachment, GLRenderbuffer, target.DepthStencilRenderbuffer)
+		}
+	}
+	if err := target.check(); err != nil {
+		target.Delete()
+		return nil, err
+	}
+	return target, nil
+}
+
+// Bind binds the Framebuffer of the RenderTarget to GLFramebuffer, and sets
+// the viewport to its size.
+func (target *RenderTarget) Bind() {
+	BindFramebuffer(GLFramebuffer, target.Framebuffer)
+	Viewport(0, 0, target.Spec.Width, target.Spec.Height)
+}
+
+// Resize reallocates every attachment with the new size, discarding their
+// contents. The names of the Framebuffer, Textures and Renderbuffers stay the
+// same. The Framebuffer is left bound to GLFramebuffer. Resize returns an
+// error if the RenderTarget has been deleted, or if the Framebuffer is not
+// complete afterwards.
+func (target *RenderTarget) Resize(width, height int32) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("gogl: render target size %dx%d is empty", width, height)
+	}
+	if target.Framebuffer == 0 {
+		return errRenderTargetDeleted
+	}
+	target.Spec.Width, target.Spec.Height = width, height
+	target.allocate()
+	BindFramebuffer(GLFramebuffer, target.Framebuffer)
+	return target.check()
+}
+
+// Resolve copies the attachments of the RenderTarget into those of
+// destination with BlitFramebuffer, which resolves the samples of a
+// multisampled RenderTarget. Every color attachment is copied into the color
+// attachment of destination with the same index, if there is one. The depth
+// and stencil are copied if both have them in the same format, as they cannot
+// be converted. The RenderTarget is left bound to GLReadFramebuffer, and
+// destination to GLDrawFramebuffer.
+//
+// Resolve returns an error without copying anything if the sizes differ, if
+// destination is multisampled, or if the RenderTarget is multisampled and the
+// formats of the color attachments differ, as OpenGL cannot copy them.
+func (target *RenderTarget) Resolve(destination *RenderTarget) error {
+	width, height := target.Spec.Width, target.Spec.Height
+	if width != destination.Spec.Width || height != destination.Spec.Height {
+		return fmt.Errorf("gogl: cannot resolve a %dx%d render target into one of %dx%d", width, height, destination.Spec.Width, destination.Spec.Height)
+	}
+	if destination.Spec.Samples > 0 {
+		return errors.New("gogl: cannot resolve into a multisampled render target")
+	}
+	colors := len(target.Spec.ColorFormats)
+	if len(destination.Spec.ColorFormats) < colors {
+		colors = len(destination.Spec.ColorFormats)
+	}
+	if target.Spec.Samples > 0 {
+		for i := 0; i < colors; i++ {
+			if source, dest := target.Spec.ColorFormats[i], destination.Spec.ColorFormats[i]; source != dest {
+				return fmt.Errorf("gogl: cannot resolve color attachment %d of format %v into %v", i, source, dest)
+			}
+		}
+	}
+
+	BindFramebuffer(GLReadFramebuffer, target.Framebuffer)
+	BindFramebuffer(GLDrawFramebuffer, destination.Framebuffer)
+	for i := 0; i < colors; i++ {
+		attachment := GLColorAttachment0 + GLEnum(i)
+		ReadBuffer(attachment)
+		DrawBuffers([]GLEnum{attachment})
+		BlitFramebuffer(0, 0, width, height, 0, 0, width, height, GLColorBufferBit, GLNearest)
+	}
+	if colors > 0 {
+		ReadBuffer(GLColorAttachment0)
+		destination.restoreDrawBuffers()
+	}
+
+	var mask GLEnum
+	if format := target.Spec.DepthStencilFormat; format == destination.Spec.DepthStencilFormat {
+		if hasDepth(format) {
+			mask |= GLDepthBufferBit
+		}
+		if hasStencil(format) {
+			mask |= GLStencilBufferBit
+		}
+	}
+	if mask != 0 {
+		BlitFramebuffer(0, 0, width, height, 0, 0, width, height, mask, GLNearest)
+	}
+	return nil
+}
+
+// restoreDrawBuffers restores the draw buffers of the bound Framebuffer of the
+// RenderTarget to its color attachments.
+func (target *RenderTarget) restoreDrawBuffers() {
+	attachments := make([]GLEnum, len(target.Spec.ColorFormats))
+	for i := range attachments {
+		attachments[i] = GLColorAttachment0 + GLEnum(i)
+	}
+	DrawBuffers(attachments)
+}
+
+// Delete deletes the Framebuffer, the Textures and the Renderbuffers of the
+// RenderTarget, and resets its fields to 0. Calling Delete again has no
+// effect.
+func (target *RenderTarget) Delete() {
+	if target.Framebuffer != 0 {
+		target.Framebuffer.Delete()
+	}
+	for _, texture := range target.ColorTextures {
+		texture.Delete()
+	}
+	for _, renderbuffer := range target.ColorRenderbuffers {
+		renderbuffer.Delete()
+	}
+	if target.DepthStencilTexture != 0 {
+		target.DepthStencilTexture.Delete()
+	}
+	if target.DepthStencilRenderbuffer != 0 {
+		target.DepthStencilRenderbuffer.Delete()
+	}
+	*target = RenderTarget{Spec: target.Spec}
+}
+
+// allocate allocates the storage of every attachment with the size of the
+// spec.
+func (target *RenderTarget) allocate() {
+	width, height := target.Spec.Width, target.Spec.Height
+	for i, format := range target.Spec.ColorFormats {
+		if target.Spec.ColorRenderbuffers {
+			target.allocateRenderbuffer(target.ColorRenderbuffers[i], format)
+		} else {
+			allocateRenderTexture(target.ColorTextures[i], format, width, height)
+		}
+	}
+	if target.DepthStencilTexture != 0 {
+		allocateRenderTexture(target.DepthStencilTexture, target.Spec.DepthStencilFormat, width, height)
+	}
+	if target.DepthStencilRenderbuffer != 0 {
+		target.allocateRenderbuffer(target.DepthStencilRenderbuffer, target.Spec.DepthStencilFormat)
+	}
+}
+
+// allocateRenderbuffer allocates the storage of a Renderbuffer of the
+// RenderTarget with the size and the samples of the spec.
+func (target *RenderTarget) allocateRenderbuffer(renderbuffer Renderbuffer, internalformat GLEnum) {
+	BindRenderbuffer(GLRenderbuffer, renderbuffer)
+	if target.Spec.Samples > 0 {
+		RenderbufferStorageMultisample(GLRenderbuffer, target.Spec.Samples, internalformat, target.Spec.Width, target.Spec.Height)
+	} else {
+		RenderbufferStorage(GLRenderbuffer, internalformat, target.Spec.Width, target.Spec.Height)
+	}
+}
+
+// check returns a *FramebufferError if the Framebuffer of the RenderTarget,
+// which must be bound to GLFramebuffer, is not complete.
+func (target *RenderTarget) check() error {
+	return ValidateFramebuffer(GLFramebuffer)
+}
+
+// createRenderTexture creates a Texture to render to, which filters linearly
+// and clamps its texture coordinates to the edges.
+func createRenderTexture() Texture {
+	texture := CreateTexture()
+	BindTexture(GLTexture2D, texture)
+	TexParameteri(GLTexture2D, GLTextureMinFilter, int32(GLLinear))
+	TexParameteri(GLTexture2D, GLTextureMagFilter, int32(GLLinear))
+	TexParameteri(GLTexture2D, GLTextureWrapS, int32(GLClampToEdge))
+	TexParameteri(GLTexture2D, GLTextureWrapT, int32(GLClampToEdge))
+	return texture
+}
+
+// allocateRenderTexture allocates the level 0 of a Texture to render to
+// without specifying its contents.
+func allocateRenderTexture(texture Texture, internalformat GLEnum, width, height int32) {
+	BindTexture(GLTexture2D, texture)
+	format, xtype := renderTextureFormat(internalformat)
+	TexImage2DEmpty(GLTexture2D, 0, internalformat, width, height, format, xtype)
+}
+
+// renderTextureFormat returns a format and type that are valid to allocate a
+// texture with the internal format without contents.
+func renderTextureFormat(internalformat GLEnum) (format, xtype GLEnum) {
+	switch internalformat {
+	case GLDepthComponent, GLDepthComponent16, GLDepthComponent24, GLDepthComponent32:
+		return GLDepthComponent, GLUInt32
+	case GLDepthComponent32F:
+		return GLDepthComponent, GLFloat32
+	case GLDepthStencil, GLDepth24Stencil8:
+		return GLDepthStencil, GLUInt248
+	case GLDepth32FStencil8:
+		return GLDepthStencil, GLFloat32UInt248Rev
+	case GLR8, GLR16F, GLR32F:
+		return GLRed, GLFloat32
+	case GLRG8, GLRG16F, GLRG32F:
+		return GLRG, GLFloat32
+	case GLRGB, GLRGB8, GLSRGB8, GLRGB565, GLRGB16F, GLRGB32F:
+		return GLRGB, GLFloat32
+	}
+	return GLRGBA, GLFloat32
+}
+
+// hasDepth reports whether a depth or stencil internal format has a depth
+// component.
+func hasDepth(internalformat GLEnum) bool {
+	return internalformat != 0 && internalformat != GLStencilIndex8
+}
+
+// hasStencil reports whether a depth or stencil internal format has a stencil
+// component.
+func hasStencil(internalformat GLEnum) bool {
+	return depthStencilAttachment(internalformat) != GLDepthAttachment
+}
+
+// depthStencilAttachment returns the attachment point of a depth or stencil
+// internal format.
+func depthStencilAttachment(internalformat GLEnum) GLEnum {
+	switch internalformat {
+	case GLDepthStencil, GLDepth24Stencil8, GLDepth32FStencil8:
+		return GLDepthStencilAttachment
+	case GLStencilIndex8:
+		return GLStencilAttachment
+	}
+	return GLDepthAttachment
+}
+
+// errRenderTargetDeleted is returned by Resize if the RenderTarget has been
+// deleted.
+var errRenderTargetDeleted = errors.New("gogl: render target has been deleted")
@@ -0,0 +1,345 @@
+package gogl_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/gogltest"
+)
+
+// callStrings returns the calls formatted by Call.String, leaving out the
+// queries.
+func callStrings(calls []gogltest.Call) []string {
+	var names []string
+	for _, call := range calls {
+		if !strings.HasPrefix(call.Name, "Get") {
+			names = append(names, call.String())
+		}
+	}
+	return names
+}
+
+func TestNewRenderTarget(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	target, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:              64,
+		Height:             32,
+		ColorFormats:       []gogl.GLEnum{gogl.GLRGBA8},
+		DepthStencilFormat: gogl.GLDepth24Stencil8,
+	})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	want := []string{
+		"CreateFramebuffer()",
+		"CreateTexture()",
+		"BindTexture(GL_TEXTURE_2D, 1)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, 9729)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, 9729)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_S, 33071)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_T, 33071)",
+		"CreateRenderbuffer()",
+		"BindTexture(GL_TEXTURE_2D, 1)",
+		"TexImage2D(GL_TEXTURE_2D, 0, GL_RGBA8, 64, 32, 0, GL_RGBA, GL_FLOAT)",
+		"BindRenderbuffer(GL_RENDERBUFFER, 1)",
+		"RenderbufferStorage(GL_RENDERBUFFER, GL_DEPTH24_STENCIL8, 64, 32)",
+		"BindFramebuffer(GL_FRAMEBUFFER, 1)",
+		"FramebufferTexture2D(GL_FRAMEBUFFER, GL_COLOR_ATTACHMENT0, GL_TEXTURE_2D, 1, 0)",
+		"FramebufferRenderbuffer(GL_FRAMEBUFFER, GL_DEPTH_STENCIL_ATTACHMENT, GL_RENDERBUFFER, 1)",
+		"CheckFramebufferStatus(GL_FRAMEBUFFER)",
+	}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewRenderTarget() calls =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if len(target.ColorTextures) != 1 || target.DepthStencilRenderbuffer != 1 || target.Framebuffer != 1 {
+		t.Errorf("NewRenderTarget() = %+v, want a color texture and a depth stencil renderbuffer", target)
+	}
+	if got := b.Binding(gogl.GLFramebuffer); got != uint32(target.Framebuffer) {
+		t.Errorf("GL_FRAMEBUFFER binding = %d, want %d", got, target.Framebuffer)
+	}
+}
+
+func TestNewRenderTargetDepthOnly(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	target, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:               512,
+		Height:              256,
+		DepthStencilFormat:  gogl.GLDepthComponent24,
+		DepthStencilTexture: true,
+	})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	want := []string{
+		"CreateFramebuffer()",
+		"CreateTexture()",
+		"BindTexture(GL_TEXTURE_2D, 1)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, 9729)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, 9729)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_S, 33071)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_T, 33071)",
+		"BindTexture(GL_TEXTURE_2D, 1)",
+		"TexImage2D(GL_TEXTURE_2D, 0, GL_DEPTH_COMPONENT24, 512, 256, 0, GL_DEPTH_COMPONENT, GL_UNSIGNED_INT)",
+		"BindFramebuffer(GL_FRAMEBUFFER, 1)",
+		"DrawBuffer(GL_POINTS/GL_ZERO/GL_NO_ERROR/GL_NONE)",
+		"ReadBuffer(GL_POINTS/GL_ZERO/GL_NO_ERROR/GL_NONE)",
+		"FramebufferTexture2D(GL_FRAMEBUFFER, GL_DEPTH_ATTACHMENT, GL_TEXTURE_2D, 1, 0)",
+		"CheckFramebufferStatus(GL_FRAMEBUFFER)",
+	}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewRenderTarget() calls =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if target.DepthStencilTexture != 1 || target.DepthStencilRenderbuffer != 0 || target.ColorTextures != nil {
+		t.Errorf("NewRenderTarget() = %+v, want only a depth texture", target)
+	}
+}
+
+func TestNewRenderTargetColors(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	target, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:              64,
+		Height:             64,
+		ColorFormats:       []gogl.GLEnum{gogl.GLRGBA8, gogl.GLRGBA16F, gogl.GLRG16F},
+		DepthStencilFormat: gogl.GLDepth24Stencil8,
+	})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	for i, texture := range target.ColorTextures {
+		attachment := gogl.GLColorAttachment0 + gogl.GLEnum(i)
+		if got := b.Attachment(target.Framebuffer, attachment); got.ObjectType != gogl.GLTexture || got.ObjectName != uint32(texture) {
+			t.Errorf("Attachment(%v) = %v, want texture %d", attachment, got, texture)
+		}
+	}
+	if got := b.Attachment(target.Framebuffer, gogl.GLDepthStencilAttachment); got.ObjectType != gogl.GLRenderbuffer || got.ObjectName != uint32(target.DepthStencilRenderbuffer) {
+		t.Errorf("Attachment(GL_DEPTH_STENCIL_ATTACHMENT) = %v, want renderbuffer %d", got, target.DepthStencilRenderbuffer)
+	}
+	calls := b.CallsTo("DrawBuffers")
+	want := []gogl.GLEnum{gogl.GLColorAttachment0, gogl.GLColorAttachment1, gogl.GLColorAttachment2}
+	if len(calls) != 1 || !reflect.DeepEqual(calls[0].Args[0], want) {
+		t.Errorf("DrawBuffers calls = %v, want DrawBuffers(%v)", calls, want)
+	}
+	if calls := b.CallsTo("DrawBuffer"); calls != nil {
+		t.Errorf("DrawBuffer calls = %v, want none", calls)
+	}
+	if got := b.Binding(gogl.GLFramebuffer); got != uint32(target.Framebuffer) {
+		t.Errorf("GL_FRAMEBUFFER binding = %d, want %d", got, target.Framebuffer)
+	}
+}
+
+func TestNewRenderTargetMultisample(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	_, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:              64,
+		Height:             32,
+		ColorFormats:       []gogl.GLEnum{gogl.GLRGBA8},
+		DepthStencilFormat: gogl.GLDepthComponent24,
+		ColorRenderbuffers: true,
+		Samples:            4,
+	})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	want := []string{
+		"RenderbufferStorageMultisample(GL_RENDERBUFFER, 4, GL_RGBA8, 64, 32)",
+		"RenderbufferStorageMultisample(GL_RENDERBUFFER, 4, GL_DEPTH_COMPONENT24, 64, 32)",
+	}
+	if got := callStrings(b.CallsTo("RenderbufferStorageMultisample")); !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderbufferStorageMultisample calls = %v, want %v", got, want)
+	}
+	if calls := b.CallsTo("RenderbufferStorage"); calls != nil {
+		t.Errorf("RenderbufferStorage calls = %v, want none", calls)
+	}
+}
+
+func TestRenderTargetResize(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	target, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:              64,
+		Height:             32,
+		ColorFormats:       []gogl.GLEnum{gogl.GLRGBA8},
+		ColorRenderbuffers: true,
+	})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	b.ClearCalls()
+	if err := target.Resize(128, 16); err != nil {
+		t.Fatalf("Resize() error = %v", err)
+	}
+	want := []string{"RenderbufferStorage(GL_RENDERBUFFER, GL_RGBA8, 128, 16)"}
+	if got := callStrings(b.CallsTo("RenderbufferStorage")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Resize() calls = %v, want %v", got, want)
+	}
+	if target.Spec.Width != 128 || target.Spec.Height != 16 {
+		t.Errorf("Spec after Resize() = %+v, want a size of 128x16", target.Spec)
+	}
+
+	target.Delete()
+	if err := target.Resize(64, 64); err == nil {
+		t.Error("Resize() of a deleted render target succeeded")
+	}
+}
+
+func TestNewRenderTargetInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec gogl.RenderTargetSpec
+		err  string
+	}{
+		{"empty", gogl.RenderTargetSpec{Width: 0, Height: 16}, "render target size 0x16 is empty"},
+		{"multisampled texture", gogl.RenderTargetSpec{Width: 16, Height: 16, ColorFormats: []gogl.GLEnum{gogl.GLRGBA8}, Samples: 4}, "must be renderbuffers"},
+		{"too many samples", gogl.RenderTargetSpec{Width: 16, Height: 16, ColorFormats: []gogl.GLEnum{gogl.GLRGBA8}, ColorRenderbuffers: true, Samples: 8}, "8 samples exceed the maximum of 4"},
+		{"too many colors", gogl.RenderTargetSpec{Width: 16, Height: 16, ColorFormats: make([]gogl.GLEnum, 9)}, "9 color attachments exceed the maximum of 8 draw buffers"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+			target, err := gogl.NewRenderTarget(test.spec)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("NewRenderTarget() = %v, %v, want error containing %q", target, err, test.err)
+			}
+			if calls := b.CallsTo("CreateFramebuffer"); calls != nil {
+				t.Errorf("NewRenderTarget() created a framebuffer for an invalid spec")
+			}
+		})
+	}
+}
+
+func TestNewRenderTargetIncomplete(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	b.FramebufferStatus = gogl.GLFramebufferUnsupported
+	target, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:              16,
+		Height:             16,
+		ColorFormats:       []gogl.GLEnum{gogl.GLRGBA32F},
+		DepthStencilFormat: gogl.GLStencilIndex8,
+	})
+	var framebufferErr *gogl.FramebufferError
+	if target != nil || !errors.As(err, &framebufferErr) {
+		t.Fatalf("NewRenderTarget() = %v, %v, want a *FramebufferError", target, err)
+	}
+	for _, kind := range []string{"framebuffer", "texture", "renderbuffer"} {
+		if !b.Deleted(kind, 1) {
+			t.Errorf("%s 1 was not deleted", kind)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	source, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:              64,
+		Height:             32,
+		ColorFormats:       []gogl.GLEnum{gogl.GLRGBA8, gogl.GLRGBA16F},
+		DepthStencilFormat: gogl.GLDepth24Stencil8,
+		ColorRenderbuffers: true,
+		Samples:            4,
+	})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	destination, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{
+		Width:              64,
+		Height:             32,
+		ColorFormats:       []gogl.GLEnum{gogl.GLRGBA8, gogl.GLRGBA16F},
+		DepthStencilFormat: gogl.GLDepth24Stencil8,
+	})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+
+	b.ClearCalls()
+	if err := source.Resolve(destination); err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	want := []string{
+		"BindFramebuffer(GL_READ_FRAMEBUFFER, 1)",
+		"BindFramebuffer(GL_DRAW_FRAMEBUFFER, 2)",
+		"ReadBuffer(GL_COLOR_ATTACHMENT0)",
+		"DrawBuffers([GL_COLOR_ATTACHMENT0])",
+		"BlitFramebuffer(0, 0, 64, 32, 0, 0, 64, 32, GL_COLOR_BUFFER_BIT, GL_NEAREST)",
+		"ReadBuffer(GL_COLOR_ATTACHMENT1)",
+		"DrawBuffers([GL_COLOR_ATTACHMENT1])",
+		"BlitFramebuffer(0, 0, 64, 32, 0, 0, 64, 32, GL_COLOR_BUFFER_BIT, GL_NEAREST)",
+		"ReadBuffer(GL_COLOR_ATTACHMENT0)",
+		"DrawBuffers([GL_COLOR_ATTACHMENT0 GL_COLOR_ATTACHMENT1])",
+		// The mask is formatted as the constant that shares its value.
+		fmt.Sprintf("BlitFramebuffer(0, 0, 64, 32, 0, 0, 64, 32, %v, GL_NEAREST)", gogl.GLDepthBufferBit|gogl.GLStencilBufferBit),
+	}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("Resolve() calls =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestResolveInvalid(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	newTarget := func(spec gogl.RenderTargetSpec) *gogl.RenderTarget {
+		target, err := gogl.NewRenderTarget(spec)
+		if err != nil {
+			t.Fatalf("NewRenderTarget() error = %v", err)
+		}
+		return target
+	}
+	multisampled := newTarget(gogl.RenderTargetSpec{Width: 64, Height: 32, ColorFormats: []gogl.GLEnum{gogl.GLRGBA8}, ColorRenderbuffers: true, Samples: 4})
+	tests := []struct {
+		name        string
+		source      *gogl.RenderTarget
+		destination *gogl.RenderTarget
+		err         string
+	}{
+		{
+			"size",
+			newTarget(gogl.RenderTargetSpec{Width: 64, Height: 32, ColorFormats: []gogl.GLEnum{gogl.GLRGBA8}}),
+			newTarget(gogl.RenderTargetSpec{Width: 32, Height: 32, ColorFormats: []gogl.GLEnum{gogl.GLRGBA8}}),
+			"cannot resolve a 64x32 render target into one of 32x32",
+		},
+		{
+			"multisampled destination",
+			newTarget(gogl.RenderTargetSpec{Width: 64, Height: 32, ColorFormats: []gogl.GLEnum{gogl.GLRGBA8}}),
+			multisampled,
+			"cannot resolve into a multisampled render target",
+		},
+		{
+			"formats",
+			multisampled,
+			newTarget(gogl.RenderTargetSpec{Width: 64, Height: 32, ColorFormats: []gogl.GLEnum{gogl.GLRGBA16F}}),
+			"cannot resolve color attachment 0 of format GL_RGBA8 into GL_RGBA16F",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b.ClearCalls()
+			err := test.source.Resolve(test.destination)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("Resolve() error = %v, want error containing %q", err, test.err)
+			}
+			if b.Calls != nil {
+				t.Errorf("Resolve() made calls for an invalid destination: %v", b.Calls)
+			}
+		})
+	}
+}
+
+func TestResolveDepthFormats(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	source, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{Width: 16, Height: 16, DepthStencilFormat: gogl.GLDepth24Stencil8})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	destination, err := gogl.NewRenderTarget(gogl.RenderTargetSpec{Width: 16, Height: 16, DepthStencilFormat: gogl.GLDepthComponent24})
+	if err != nil {
+		t.Fatalf("NewRenderTarget() error = %v", err)
+	}
+	b.ClearCalls()
+	if err := source.Resolve(destination); err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if calls := b.CallsTo("BlitFramebuffer"); calls != nil {
+		t.Errorf("Resolve() blitted between depth formats that differ: %v", calls)
+	}
+}
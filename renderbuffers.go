@@ -5,103 +5,98 @@ import "github.com/go-gl/gl/v2.1/gl"
 // BindRenderbuffer binds a given Renderbuffer to a target, which must be
 // GLRenderbuffer.
 func BindRenderbuffer(target GLEnum, renderbuffer Renderbuffer) {
-	gl.BindRenderbuffer(uint32(target), uint32(renderbuffer))
+	backend.BindRenderbuffer(target, renderbuffer)
 }
 
 // CreateRenderbuffer creates and initializes a Renderbuffer object.
 func CreateRenderbuffer() Renderbuffer {
-	var renderbuffer uint32
-	gl.GenRenderbuffers(1, &renderbuffer)
-	return Renderbuffer(renderbuffer)
+	return backend.CreateRenderbuffer()
 }
 
 // Delete deletes the Renderbuffer object. This function has no effect if the
 // render buffer has already been deleted.
 func (renderbuffer Renderbuffer) Delete() {
-	// TODO: Is it somehow possible to get &uint32(renderbuffer) without assigning it to renderbuffers?
-	renderbuffers := uint32(renderbuffer)
-	gl.DeleteRenderbuffers(1, &renderbuffers)
+	backend.DeleteRenderbuffer(renderbuffer)
 }
 
 // GetRenderbufferWidth returns an int32 indicating the width of the image of
 // the currently bound renderbuffer.
 func GetRenderbufferWidth(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_WIDTH, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_WIDTH)
 }
 
 // GetRenderbufferHeight returns an int32 indicating the height of the image of
 // the currently bound renderbuffer.
 func GetRenderbufferHeight(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_HEIGHT, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_HEIGHT)
 }
 
 // GetRenderbufferInternalFormat returns a GLEnum indicating the internal format
 // of the currently bound renderbuffer. The default is GLRGBA4.
 func GetRenderbufferInternalFormat(target GLEnum) GLEnum {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_INTERNAL_FORMAT, &params)
-	return GLEnum(params)
+	return GLEnum(backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_INTERNAL_FORMAT))
 }
 
 // GetRenderbufferGreenSize returns an int32 that is the resolution size (in
 // bits) for the green color.
 func GetRenderbufferGreenSize(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_GREEN_SIZE, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_GREEN_SIZE)
 }
 
 // GetRenderbufferBlueSize returns an int32 that is the resolution size (in
 // bits) for the blue color.
 func GetRenderbufferBlueSize(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_BLUE_SIZE, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_BLUE_SIZE)
 }
 
 // GetRenderbufferRedSize returns an int32 that is the resolution size (in bits)
 // for the red color.
 func GetRenderbufferRedSize(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_RED_SIZE, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_RED_SIZE)
 }
 
 // GetRenderbufferAlphaSize returns an int32 that is the resolution size (in
 // bits) for the alpha component.
 func GetRenderbufferAlphaSize(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_ALPHA_SIZE, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_ALPHA_SIZE)
 }
 
 // GetRenderbufferDepthSize returns an int32 that is the resolution size (in
 // bits) for the depth component.
 func GetRenderbufferDepthSize(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_DEPTH_SIZE, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_DEPTH_SIZE)
+}
+
+// GetRenderbufferSamples returns an int32 that is the number of samples of
+// the image of the currently bound renderbuffer, or 0 if it is not
+// multisampled.
+func GetRenderbufferSamples(target GLEnum) int32 {
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_SAMPLES)
 }
 
 // GetRenderbufferStencilSize returns an int32 that is the resolution size (in
 // bits) for the stencil component.
 func GetRenderbufferStencilSize(target GLEnum) int32 {
-	var params int32
-	gl.GetRenderbufferParameteriv(uint32(target), gl.RENDERBUFFER_STENCIL_SIZE, &params)
-	return params
+	return backend.GetRenderbufferParameteri(target, gl.RENDERBUFFER_STENCIL_SIZE)
 }
 
 // IsRenderbuffer returns true if the Renderbuffer is valid and false otherwise.
 func (renderbuffer Renderbuffer) IsRenderbuffer() bool {
-	return gl.IsRenderbuffer(uint32(renderbuffer))
+	return backend.IsRenderbuffer(renderbuffer)
 }
 
 // RenderbufferStorage creates and initializes a renderbuffer object's data
 // store.
 func RenderbufferStorage(target, internalFormat GLEnum, width, height int32) {
-	gl.RenderbufferStorage(uint32(target), uint32(internalFormat), width, height)
+	backend.RenderbufferStorage(target, internalFormat, width, height)
+}
+
+// RenderbufferStorageMultisample is like RenderbufferStorage, but creates a
+// multisampled data store with at least the given number of samples, which
+// must not exceed GetMaxSamples. A samples of 0 creates a store that is not
+// multisampled. Multisampled renderbuffers cannot be read directly; they are
+// resolved by copying them into a non-multisampled framebuffer with
+// BlitFramebuffer.
+func RenderbufferStorageMultisample(target GLEnum, samples int32, internalFormat GLEnum, width, height int32) {
+	backend.RenderbufferStorageMultisample(target, samples, internalFormat, width, height)
 }
@@ -0,0 +1,202 @@
+package gogl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Diagnostic is a single error or warning of a shader info log.
+type Diagnostic struct {
+	// File is the index of the source string the diagnostic refers to, which
+	// is 0 for shaders created from a single source.
+	File int
+	// Line and Column are the position the diagnostic refers to. They are 0 if
+	// the driver does not report them.
+	Line, Column int
+	// Severity is the lower-cased severity reported by the driver, usually
+	// "error" or "warning". It is empty if the driver does not report it.
+	Severity string
+	// Message is the message of the diagnostic.
+	Message string
+}
+
+// String returns the diagnostic formatted as "file:line:column: severity:
+// message", leaving out the parts that are unknown.
+func (diagnostic Diagnostic) String() string {
+	var b strings.Builder
+	if diagnostic.Line > 0 {
+		fmt.Fprintf(&b, "%d:%d:", diagnostic.File, diagnostic.Line)
+		if diagnostic.Column > 0 {
+			fmt.Fprintf(&b, "%d:", diagnostic.Column)
+		}
+		b.WriteString(" ")
+	}
+	if diagnostic.Severity != "" {
+		b.WriteString(diagnostic.Severity + ": ")
+	}
+	b.WriteString(diagnostic.Message)
+	return b.String()
+}
+
+// CompileError is returned by CompileShader if the compilation of a shader
+// fails.
+type CompileError struct {
+	// ShaderType is either GLVertexShader or GLFragmentShader.
+	ShaderType GLEnum
+	// InfoLog is the info log of the shader.
+	InfoLog string
+	// Diagnostics are the lines of the info log.
+	Diagnostics []Diagnostic
+}
+
+// Error returns the error followed by the diagnostics, one per line.
+func (err *CompileError) Error() string {
+	return formatDiagnostics(fmt.Sprintf("gogl: compiling %v failed", err.ShaderType), err.Diagnostics)
+}
+
+// LinkError is returned by LinkProgram if linking a program fails.
+type LinkError struct {
+	// InfoLog is the info log of the program.
+	InfoLog string
+	// Diagnostics are the lines of the info log.
+	Diagnostics []Diagnostic
+}
+
+// Error returns the error followed by the diagnostics, one per line.
+func (err *LinkError) Error() string {
+	return formatDiagnostics("gogl: linking program failed", err.Diagnostics)
+}
+
+// CompileShader creates a Shader of the given type, sets its source and
+// compiles it.
+//
+// If the compilation fails, the Shader is deleted and a *CompileError holding
+// the info log is returned.
+func CompileShader(xtype GLEnum, source string) (Shader, error) {
+	shader := CreateShader(xtype)
+	shader.Source(source)
+	shader.Compile()
+	if !shader.GetCompileStatus() {
+		infoLog := shader.GetInfoLog()
+		shader.Delete()
+		return 0, &CompileError{
+			ShaderType:  xtype,
+			InfoLog:     infoLog,
+			Diagnostics: ParseInfoLog(infoLog),
+		}
+	}
+	return shader, nil
+}
+
+// LinkProgram creates a Program, attaches the shaders to it and links it. The
+// shaders stay attached, and may be deleted by the caller once the Program is
+// linked.
+//
+// If linking fails, the Program is deleted and a *LinkError holding the info
+// log is returned.
+func LinkProgram(shaders ...Shader) (Program, error) {
+	program := CreateProgram()
+	for _, shader := range shaders {
+		program.AttachShader(shader)
+	}
+	program.Link()
+	if !program.GetLinkStatus() {
+		infoLog := program.GetInfoLog()
+		program.Delete()
+		return 0, &LinkError{
+			InfoLog:     infoLog,
+			Diagnostics: ParseInfoLog(infoLog),
+		}
+	}
+	return program, nil
+}
+
+var (
+	// mesaDiagnostic matches Mesa's format, e.g.,
+	// "0:12(5): error: syntax error".
+	mesaDiagnostic = regexp.MustCompile(`^(\d+):(\d+)\((\d+)\):\s*(\w+):\s*(.*)$`)
+	// nvidiaDiagnostic matches NVIDIA's format, e.g.,
+	// "0(12) : error C1008: undefined variable".
+	nvidiaDiagnostic = regexp.MustCompile(`^(\d+)\((\d+)\)\s*:\s*(\w+)\s+(.*)$`)
+	// prefixedDiagnostic matches the format of AMD, Intel, Apple and ANGLE,
+	// e.g., "ERROR: 0:12: 'x' : undeclared identifier".
+	prefixedDiagnostic = regexp.MustCompile(`^(\w+):\s*(\d+):(\d+):\s*(.*)$`)
+	// severityDiagnostic matches diagnostics without a position, which are
+	// common in link logs, e.g., "error: vertex shader output not read".
+	severityDiagnostic = regexp.MustCompile(`^(?i)(error|warning|info):\s*(.*)$`)
+)
+
+// ParseInfoLog parses the info log of a Shader or Program into diagnostics,
+// one per non-empty line. It understands the formats of the common drivers.
+// Lines of other formats are returned as diagnostics holding only a message.
+func ParseInfoLog(infoLog string) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, line := range strings.Split(trimInfoLog(infoLog), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		diagnostics = append(diagnostics, parseDiagnostic(line))
+	}
+	return diagnostics
+}
+
+// parseDiagnostic parses a single line of an info log.
+func parseDiagnostic(line string) Diagnostic {
+	if m := mesaDiagnostic.FindStringSubmatch(line); m != nil {
+		return Diagnostic{
+			File:     atoi(m[1]),
+			Line:     atoi(m[2]),
+			Column:   atoi(m[3]),
+			Severity: strings.ToLower(m[4]),
+			Message:  m[5],
+		}
+	}
+	if m := nvidiaDiagnostic.FindStringSubmatch(line); m != nil {
+		return Diagnostic{
+			File:     atoi(m[1]),
+			Line:     atoi(m[2]),
+			Severity: strings.ToLower(m[3]),
+			Message:  m[4],
+		}
+	}
+	if m := prefixedDiagnostic.FindStringSubmatch(line); m != nil {
+		return Diagnostic{
+			File:     atoi(m[2]),
+			Line:     atoi(m[3]),
+			Severity: strings.ToLower(m[1]),
+			Message:  m[4],
+		}
+	}
+	if m := severityDiagnostic.FindStringSubmatch(line); m != nil {
+		return Diagnostic{
+			Severity: strings.ToLower(m[1]),
+			Message:  m[2],
+		}
+	}
+	return Diagnostic{Message: line}
+}
+
+// trimInfoLog removes the trailing NUL bytes and whitespace some drivers pad
+// info logs with.
+func trimInfoLog(infoLog string) string {
+	return strings.TrimRight(infoLog, "\x00 \t\r\n")
+}
+
+// formatDiagnostics returns the message followed by the diagnostics, one per
+// line.
+func formatDiagnostics(message string, diagnostics []Diagnostic) string {
+	var b strings.Builder
+	b.WriteString(message)
+	for _, diagnostic := range diagnostics {
+		b.WriteString("\n\t" + diagnostic.String())
+	}
+	return b.String()
+}
+
+func atoi(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
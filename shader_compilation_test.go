@@ -0,0 +1,77 @@
+package gogl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseInfoLog(t *testing.T) {
+	tests := []struct {
+		name    string
+		infoLog string
+		want    []Diagnostic
+	}{
+		{
+			"empty",
+			"\x00",
+			nil,
+		},
+		{
+			"Mesa",
+			"0:12(5): error: syntax error, unexpected IDENTIFIER\n0:14(2): warning: `x' used uninitialized\n\x00",
+			[]Diagnostic{
+				{File: 0, Line: 12, Column: 5, Severity: "error", Message: "syntax error, unexpected IDENTIFIER"},
+				{File: 0, Line: 14, Column: 2, Severity: "warning", Message: "`x' used uninitialized"},
+			},
+		},
+		{
+			"NVIDIA",
+			"0(12) : error C1008: undefined variable \"x\"\r\n1(3) : warning C7050: \"y\" might be used before being initialized\r\n",
+			[]Diagnostic{
+				{File: 0, Line: 12, Severity: "error", Message: "C1008: undefined variable \"x\""},
+				{File: 1, Line: 3, Severity: "warning", Message: "C7050: \"y\" might be used before being initialized"},
+			},
+		},
+		{
+			"prefixed",
+			"ERROR: 0:12: 'x' : undeclared identifier\nWARNING: 2:7: extension not supported\nERROR: 1 compilation errors.  No code generated.",
+			[]Diagnostic{
+				{File: 0, Line: 12, Severity: "error", Message: "'x' : undeclared identifier"},
+				{File: 2, Line: 7, Severity: "warning", Message: "extension not supported"},
+				{Severity: "error", Message: "1 compilation errors.  No code generated."},
+			},
+		},
+		{
+			"link",
+			"error: vertex shader output `v_color' not read by fragment shader\n\nLinking failed.",
+			[]Diagnostic{
+				{Severity: "error", Message: "vertex shader output `v_color' not read by fragment shader"},
+				{Message: "Linking failed."},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ParseInfoLog(test.infoLog); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseInfoLog(%q) = %#v, want %#v", test.infoLog, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDiagnosticString(t *testing.T) {
+	tests := []struct {
+		diagnostic Diagnostic
+		want       string
+	}{
+		{Diagnostic{File: 0, Line: 12, Column: 5, Severity: "error", Message: "syntax error"}, "0:12:5: error: syntax error"},
+		{Diagnostic{File: 1, Line: 3, Severity: "warning", Message: "unused"}, "1:3: warning: unused"},
+		{Diagnostic{Severity: "error", Message: "not linked"}, "error: not linked"},
+		{Diagnostic{Message: "Linking failed."}, "Linking failed."},
+	}
+	for _, test := range tests {
+		if got := test.diagnostic.String(); got != test.want {
+			t.Errorf("%#v.String() = %q, want %q", test.diagnostic, got, test.want)
+		}
+	}
+}
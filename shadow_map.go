@@ -0,0 +1,32 @@
+package gogl
+
+// NewShadowMap creates a depth-only RenderTarget of the given size for
+// rendering a shadow map. Its depth is stored in the Texture
+// DepthStencilTexture with the internal format, e.g., GLDepthComponent24 or
+// GLDepthComponent32F, which defaults to GLDepthComponent24 if it is 0.
+//
+// The Framebuffer has no color attachment, so its draw and read buffers are
+// set to GLNone. The Texture compares the texture coordinate r to its depth
+// with GLLEqual, i.e., it is sampled with a sampler2DShadow in GLSL, which
+// returns 1 for lit and 0 for shadowed fragments. It filters linearly, which
+// averages the results of neighboring comparisons on most hardware, and
+// clamps its texture coordinates to the edges.
+//
+// Like NewRenderTarget, NewShadowMap leaves the Framebuffer bound to
+// GLFramebuffer. Bind binds it and sets the viewport for rendering the shadow
+// casters.
+func NewShadowMap(width, height int32, internalformat GLEnum) (*RenderTarget, error) {
+	target, err := NewRenderTarget(RenderTargetSpec{
+		Width:               width,
+		Height:              height,
+		DepthStencilFormat:  orDefault(internalformat, GLDepthComponent24),
+		DepthStencilTexture: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	BindTexture(GLTexture2D, target.DepthStencilTexture)
+	TexParameteri(GLTexture2D, GLTextureCompareMode, int32(GLCompareRefToTexture))
+	TexParameteri(GLTexture2D, GLTextureCompareFunc, int32(GLLEqual))
+	return target, nil
+}
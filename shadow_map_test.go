@@ -0,0 +1,83 @@
+package gogl_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestNewShadowMap(t *testing.T) {
+	tests := []struct {
+		name           string
+		internalformat gogl.GLEnum
+		want           string
+	}{
+		{"default", 0, "TexImage2D(GL_TEXTURE_2D, 0, GL_DEPTH_COMPONENT24, 1024, 512, 0, GL_DEPTH_COMPONENT, GL_UNSIGNED_INT)"},
+		{"float", gogl.GLDepthComponent32F, "TexImage2D(GL_TEXTURE_2D, 0, GL_DEPTH_COMPONENT32F, 1024, 512, 0, GL_DEPTH_COMPONENT, GL_FLOAT)"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+			shadowMap, err := gogl.NewShadowMap(1024, 512, test.internalformat)
+			if err != nil {
+				t.Fatalf("NewShadowMap() error = %v", err)
+			}
+			texture := shadowMap.DepthStencilTexture
+			if texture == 0 || shadowMap.DepthStencilRenderbuffer != 0 || len(shadowMap.ColorTextures) != 0 {
+				t.Fatalf("NewShadowMap() = %+v, want only a depth texture", shadowMap)
+			}
+
+			calls := callStrings(b.Calls)
+			if got := calls[len(calls)-3:]; !reflect.DeepEqual(got, []string{
+				fmt.Sprintf("BindTexture(GL_TEXTURE_2D, %d)", texture),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_COMPARE_MODE, %d)", gogl.GLCompareRefToTexture),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_COMPARE_FUNC, %d)", gogl.GLLEqual),
+			}) {
+				t.Errorf("NewShadowMap() calls end with\n%s\nwant the compare mode and function of the depth texture", strings.Join(got, "\n"))
+			}
+			for _, want := range []string{
+				test.want,
+				"DrawBuffer(GL_POINTS/GL_ZERO/GL_NO_ERROR/GL_NONE)",
+				"ReadBuffer(GL_POINTS/GL_ZERO/GL_NO_ERROR/GL_NONE)",
+			} {
+				if !containsString(calls, want) {
+					t.Errorf("NewShadowMap() calls =\n%s\nwant %s", strings.Join(calls, "\n"), want)
+				}
+			}
+
+			depth := b.Attachment(shadowMap.Framebuffer, gogl.GLDepthAttachment)
+			if depth.ObjectType != gogl.GLTexture || depth.ObjectName != uint32(texture) || depth.TextureLevel != 0 {
+				t.Errorf("depth attachment = %+v, want level 0 of texture %d", depth, texture)
+			}
+			if color := b.Attachment(shadowMap.Framebuffer, gogl.GLColorAttachment0); color.ObjectType != gogl.GLNone {
+				t.Errorf("color attachment = %+v, want none", color)
+			}
+			if got := b.Binding(gogl.GLFramebuffer); got != uint32(shadowMap.Framebuffer) {
+				t.Errorf("bound framebuffer = %d, want %d", got, shadowMap.Framebuffer)
+			}
+		})
+	}
+}
+
+func TestNewShadowMapInvalid(t *testing.T) {
+	b := initBackend(t, "3.3.0 NVIDIA 535.54", "")
+	if _, err := gogl.NewShadowMap(0, 512, 0); err == nil {
+		t.Error("NewShadowMap() with an empty size error = nil, want an error")
+	}
+	if calls := b.CallsTo("TexParameteri"); len(calls) != 0 {
+		t.Errorf("TexParameteri called %v, want no calls", calls)
+	}
+}
+
+// containsString reports whether s is an element of list.
+func containsString(list []string, s string) bool {
+	for _, element := range list {
+		if element == s {
+			return true
+		}
+	}
+	return false
+}
@@ -4,12 +4,12 @@ import "github.com/go-gl/gl/v2.1/gl"
 
 // ActiveTexture specifies which texture unit to make active.
 func ActiveTexture(texture GLEnum) {
-	gl.ActiveTexture(uint32(texture))
+	backend.ActiveTexture(texture)
 }
 
 // BlendColor is used to set the source and destination blending factors.
 func BlendColor(red, green, blue, alpha float32) {
-	gl.BlendColor(red, green, blue, alpha)
+	backend.BlendColor(red, green, blue, alpha)
 }
 
 // BlendEquation is used to set both the RGB blend equation and alpha blend
@@ -18,7 +18,7 @@ func BlendColor(red, green, blue, alpha float32) {
 // The blend equation determines how a new pixel is combined with a pixel
 // already in the Framebuffer.
 func BlendEquation(mode GLEnum) {
-	gl.BlendEquation(uint32(mode))
+	backend.BlendEquation(mode)
 }
 
 // BlendEquationSeparate is used to set the RGB blend equation and alpha blend
@@ -27,18 +27,18 @@ func BlendEquation(mode GLEnum) {
 // The blend equation determines how a new pixel is combined with a pixel
 // already in the Framebuffer.
 func BlendEquationSeparate(modeRGB, modeAlpha GLEnum) {
-	gl.BlendEquationSeparate(uint32(modeRGB), uint32(modeAlpha))
+	backend.BlendEquationSeparate(modeRGB, modeAlpha)
 }
 
 // BlendFunc defines which function is used for blending pixel arithmetic.
 func BlendFunc(sfactor, dfactor GLEnum) {
-	gl.BlendFunc(uint32(sfactor), uint32(dfactor))
+	backend.BlendFunc(sfactor, dfactor)
 }
 
 // BlendFuncSeparate defines which function is used for blending pixel
 // arithmetic for RGB and alpha components separately.
 func BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLEnum) {
-	gl.BlendFuncSeparate(uint32(srcRGB), uint32(dstRGB), uint32(srcAlpha), uint32(dstAlpha))
+	backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
 }
 
 // ClearColor specifies the color values used when clearing color buffers.
@@ -46,7 +46,7 @@ func BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha GLEnum) {
 // This specifies what color values to use when calling the Clear method. The
 // values are clamped between 0 and 1.
 func ClearColor(red, green, blue, alpha float32) {
-	gl.ClearColor(red, green, blue, alpha)
+	backend.ClearColor(red, green, blue, alpha)
 }
 
 // ClearDepth specifies the clear value for the depth buffer.
@@ -54,177 +54,177 @@ func ClearColor(red, green, blue, alpha float32) {
 // This specifies what depth value to use when calling the Clear method. The
 // value is clamped between 0 and 1.
 func ClearDepth(depth float32) {
-	gl.ClearDepth(float64(depth))
+	backend.ClearDepth(depth)
 }
 
 // ClearStencil specifies the clear value for the stencil buffer.
 //
 // This specifies what stencil value to use when calling the Clear method.
 func ClearStencil(s int32) {
-	gl.ClearStencil(s)
+	backend.ClearStencil(s)
 }
 
 // ColorMask sets which color components to enable or to disable when drawing or
 // rendering to a Framebuffer.
 func ColorMask(red, green, blue, alpha bool) {
-	gl.ColorMask(red, green, blue, alpha)
+	backend.ColorMask(red, green, blue, alpha)
 }
 
 // CullFace specifies whether or not front- and/or back-facing polygons can be
 // culled.
 func CullFace(mode GLEnum) {
-	gl.CullFace(uint32(mode))
+	backend.CullFace(mode)
 }
 
 // DepthFunc specifies a function that compares incoming pixel depth to the
 // current depth buffer value.
 func DepthFunc(xfunc GLEnum) {
-	gl.DepthFunc(uint32(xfunc))
+	backend.DepthFunc(xfunc)
 }
 
 // DepthMask sets whether writing into the depth buffer is enabled or disabled.
 func DepthMask(flag bool) {
-	gl.DepthMask(flag)
+	backend.DepthMask(flag)
 }
 
 // DepthRange specifies the depth range mapping from normalized device
 // coordinates to window or viewport coordinates.
 func DepthRange(zNear, zFar float32) {
-	gl.DepthRange(float64(zNear), float64(zFar))
+	backend.DepthRange(zNear, zFar)
 }
 
 // Disable disables specific OpenGL capabilities.
 func Disable(cap GLEnum) {
-	gl.Disable(uint32(cap))
+	backend.Disable(cap)
 }
 
 // Enable enables specific OpenGL capabilities.
 func Enable(cap GLEnum) {
-	gl.Enable(uint32(cap))
+	backend.Enable(cap)
 }
 
 // FrontFace specifies whether polygons are front- or back-facing by setting a
 // winding orientation.
 func FrontFace(mode GLEnum) {
-	gl.FrontFace(uint32(mode))
+	backend.FrontFace(mode)
 }
 
 // GetActiveTexture returns a value for the passed parameter name.
 func GetActiveTexture() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.ACTIVE_TEXTURE, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.ACTIVE_TEXTURE, data[:])
+	return GLEnum(data[0])
 }
 
 // GetAliasedLineWidthRange returns a value for the passed parameter name.
 func GetAliasedLineWidthRange() [2]float32 {
 	var data [2]float32
-	gl.GetFloatv(gl.ALIASED_LINE_WIDTH_RANGE, &data[0])
+	backend.GetFloatv(gl.ALIASED_LINE_WIDTH_RANGE, data[:])
 	return data
 }
 
 // GetAliasedPointSizeRange returns a value for the passed parameter name.
 func GetAliasedPointSizeRange() [2]float32 {
 	var data [2]float32
-	gl.GetFloatv(gl.ALIASED_POINT_SIZE_RANGE, &data[0])
+	backend.GetFloatv(gl.ALIASED_POINT_SIZE_RANGE, data[:])
 	return data
 }
 
 // GetAlphaBits returns a value for the passed parameter name.
 func GetAlphaBits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.ALPHA_BITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.ALPHA_BITS, data[:])
+	return data[0]
 }
 
 // GetArrayBufferBinding returns a value for the passed parameter name.
 func GetArrayBufferBinding() Buffer {
-	var data int32
-	gl.GetIntegerv(gl.ARRAY_BUFFER_BINDING, &data)
-	return Buffer(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.ARRAY_BUFFER_BINDING, data[:])
+	return Buffer(data[0])
 }
 
 // GetBlend returns a value for the passed parameter name.
 func GetBlend() bool {
-	var data bool
-	gl.GetBooleanv(gl.BLEND, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.BLEND, data[:])
+	return data[0]
 }
 
 // GetBlendColor returns a value for the passed parameter name.
 func GetBlendColor() [4]float32 {
 	var data [4]float32
-	gl.GetFloatv(gl.BLEND_COLOR, &data[0])
+	backend.GetFloatv(gl.BLEND_COLOR, data[:])
 	return data
 }
 
 // GetBlendDstAlpha returns a value for the passed parameter name.
 func GetBlendDstAlpha() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.BLEND_DST_ALPHA, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.BLEND_DST_ALPHA, data[:])
+	return GLEnum(data[0])
 }
 
 // GetBlendDstRGB returns a value for the passed parameter name.
 func GetBlendDstRGB() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.BLEND_DST_RGB, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.BLEND_DST_RGB, data[:])
+	return GLEnum(data[0])
 }
 
 // GetBlendEquation returns a value for the passed parameter name.
 func GetBlendEquation() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.BLEND_EQUATION, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.BLEND_EQUATION, data[:])
+	return GLEnum(data[0])
 }
 
 // GetBlendEquationAlpha returns a value for the passed parameter name.
 func GetBlendEquationAlpha() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.BLEND_EQUATION_ALPHA, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.BLEND_EQUATION_ALPHA, data[:])
+	return GLEnum(data[0])
 }
 
 // GetBlendEquationRGB returns a value for the passed parameter name.
 func GetBlendEquationRGB() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.BLEND_EQUATION_RGB, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.BLEND_EQUATION_RGB, data[:])
+	return GLEnum(data[0])
 }
 
 // GetBlendSrcAlpha returns a value for the passed parameter name.
 func GetBlendSrcAlpha() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.BLEND_SRC_ALPHA, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.BLEND_SRC_ALPHA, data[:])
+	return GLEnum(data[0])
 }
 
 // GetBlendSrcRGB returns a value for the passed parameter name.
 func GetBlendSrcRGB() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.BLEND_SRC_RGB, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.BLEND_SRC_RGB, data[:])
+	return GLEnum(data[0])
 }
 
 // GetBlueBits returns a value for the passed parameter name.
 func GetBlueBits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.BLUE_BITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.BLUE_BITS, data[:])
+	return data[0]
 }
 
 // GetColorClearValue returns a value for the passed parameter name.
 func GetColorClearValue() [4]float32 {
 	var data [4]float32
-	gl.GetFloatv(gl.COLOR_CLEAR_VALUE, &data[0])
+	backend.GetFloatv(gl.COLOR_CLEAR_VALUE, data[:])
 	return data
 }
 
 // GetColorWritemask returns a value for the passed parameter name.
 func GetColorWritemask() [4]bool {
 	var data [4]bool
-	gl.GetBooleanv(gl.COLOR_WRITEMASK, &data[0])
+	backend.GetBooleanv(gl.COLOR_WRITEMASK, data[:])
 	return data
 }
 
@@ -232,442 +232,492 @@ func GetColorWritemask() [4]bool {
 
 // GetCullFace returns a value for the passed parameter name.
 func GetCullFace() bool {
-	var data bool
-	gl.GetBooleanv(gl.CULL_FACE, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.CULL_FACE, data[:])
+	return data[0]
 }
 
 // GetCullFaceMode returns a value for the passed parameter name.
 func GetCullFaceMode() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.CULL_FACE_MODE, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.CULL_FACE_MODE, data[:])
+	return GLEnum(data[0])
 }
 
 // GetCurrentProgram returns a value for the passed parameter name.
 func GetCurrentProgram() Program {
-	var data int32
-	gl.GetIntegerv(gl.CURRENT_PROGRAM, &data)
-	return Program(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.CURRENT_PROGRAM, data[:])
+	return Program(data[0])
 }
 
 // GetDepthBits returns a value for the passed parameter name.
 func GetDepthBits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.DEPTH_BITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.DEPTH_BITS, data[:])
+	return data[0]
 }
 
 // GetDepthClearValue returns a value for the passed parameter name.
 func GetDepthClearValue() float32 {
-	var data float32
-	gl.GetFloatv(gl.DEPTH_CLEAR_VALUE, &data)
-	return data
+	var data [1]float32
+	backend.GetFloatv(gl.DEPTH_CLEAR_VALUE, data[:])
+	return data[0]
 }
 
 // GetDepthFunc returns a value for the passed parameter name.
 func GetDepthFunc() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.DEPTH_FUNC, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.DEPTH_FUNC, data[:])
+	return GLEnum(data[0])
 }
 
 // GetDepthRange returns a value for the passed parameter name.
 func GetDepthRange() [2]float32 {
 	var data [2]float32
-	gl.GetFloatv(gl.DEPTH_RANGE, &data[0])
+	backend.GetFloatv(gl.DEPTH_RANGE, data[:])
 	return data
 }
 
 // GetDepthTest returns a value for the passed parameter name.
 func GetDepthTest() bool {
-	var data bool
-	gl.GetBooleanv(gl.DEPTH_TEST, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.DEPTH_TEST, data[:])
+	return data[0]
 }
 
 // GetDepthWritemask returns a value for the passed parameter name.
 func GetDepthWritemask() bool {
-	var data bool
-	gl.GetBooleanv(gl.DEPTH_WRITEMASK, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.DEPTH_WRITEMASK, data[:])
+	return data[0]
 }
 
 // GetDither returns a value for the passed parameter name.
 func GetDither() bool {
-	var data bool
-	gl.GetBooleanv(gl.DITHER, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.DITHER, data[:])
+	return data[0]
+}
+
+// GetDrawBuffer returns a value for the passed parameter name.
+func GetDrawBuffer() GLEnum {
+	var data [1]int32
+	backend.GetIntegerv(gl.DRAW_BUFFER, data[:])
+	return GLEnum(data[0])
+}
+
+// GetDrawFramebufferBinding returns a value for the passed parameter name. It
+// is the same as GetFramebufferBinding.
+func GetDrawFramebufferBinding() Framebuffer {
+	var data [1]int32
+	backend.GetIntegerv(gl.DRAW_FRAMEBUFFER_BINDING, data[:])
+	return Framebuffer(data[0])
 }
 
 // GetElementArrayBufferBinding returns a value for the passed parameter name.
 func GetElementArrayBufferBinding() Buffer {
-	var data int32
-	gl.GetIntegerv(gl.ELEMENT_ARRAY_BUFFER_BINDING, &data)
-	return Buffer(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.ELEMENT_ARRAY_BUFFER_BINDING, data[:])
+	return Buffer(data[0])
 }
 
 // GetFramebufferBinding returns a value for the passed parameter name.
 func GetFramebufferBinding() Framebuffer {
-	var data int32
-	gl.GetIntegerv(gl.FRAMEBUFFER_BINDING, &data)
-	return Framebuffer(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.FRAMEBUFFER_BINDING, data[:])
+	return Framebuffer(data[0])
 }
 
 // GetFrontFace returns a value for the passed parameter name.
 func GetFrontFace() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.FRONT_FACE, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.FRONT_FACE, data[:])
+	return GLEnum(data[0])
 }
 
 // GetGenerateMipmapHint returns a value for the passed parameter name.
 func GetGenerateMipmapHint() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.GENERATE_MIPMAP_HINT, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.GENERATE_MIPMAP_HINT, data[:])
+	return GLEnum(data[0])
 }
 
 // GetGreenBits returns a value for the passed parameter name.
 func GetGreenBits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.GREEN_BITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.GREEN_BITS, data[:])
+	return data[0]
 }
 
 // GetImplementationColorReadFormat returns a value for the passed parameter
 // name.
 func GetImplementationColorReadFormat() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.IMPLEMENTATION_COLOR_READ_FORMAT, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.IMPLEMENTATION_COLOR_READ_FORMAT, data[:])
+	return GLEnum(data[0])
 }
 
 // GetImplementationColorReadType returns a value for the passed parameter name.
 func GetImplementationColorReadType() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.IMPLEMENTATION_COLOR_READ_TYPE, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.IMPLEMENTATION_COLOR_READ_TYPE, data[:])
+	return GLEnum(data[0])
 }
 
 // GetLineWidth returns a value for the passed parameter name.
 func GetLineWidth() float32 {
-	var data float32
-	gl.GetFloatv(gl.LINE_WIDTH, &data)
-	return data
+	var data [1]float32
+	backend.GetFloatv(gl.LINE_WIDTH, data[:])
+	return data[0]
+}
+
+// GetMaxColorAttachments returns a value for the passed parameter name.
+func GetMaxColorAttachments() int32 {
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_COLOR_ATTACHMENTS, data[:])
+	return data[0]
 }
 
 // GetMaxCombinedTextureImageUnits returns a value for the passed parameter
 // name.
 func GetMaxCombinedTextureImageUnits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS, data[:])
+	return data[0]
 }
 
 // GetMaxCubeMapTextureSize returns a value for the passed parameter name.
 func GetMaxCubeMapTextureSize() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_CUBE_MAP_TEXTURE_SIZE, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_CUBE_MAP_TEXTURE_SIZE, data[:])
+	return data[0]
+}
+
+// GetMaxDrawBuffers returns a value for the passed parameter name.
+func GetMaxDrawBuffers() int32 {
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_DRAW_BUFFERS, data[:])
+	return data[0]
 }
 
 // GetMaxFragmentUniformVectors returns a value for the passed parameter name.
 func GetMaxFragmentUniformVectors() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_FRAGMENT_UNIFORM_VECTORS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_FRAGMENT_UNIFORM_VECTORS, data[:])
+	return data[0]
 }
 
 // GetMaxRenderbufferSize returns a value for the passed parameter name.
 func GetMaxRenderbufferSize() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_RENDERBUFFER_SIZE, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_RENDERBUFFER_SIZE, data[:])
+	return data[0]
+}
+
+// GetMaxSamples returns a value for the passed parameter name.
+func GetMaxSamples() int32 {
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_SAMPLES, data[:])
+	return data[0]
 }
 
 // GetMaxTextureImageUnits returns a value for the passed parameter name.
 func GetMaxTextureImageUnits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_TEXTURE_IMAGE_UNITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_TEXTURE_IMAGE_UNITS, data[:])
+	return data[0]
 }
 
 // GetMaxTextureSize returns a value for the passed parameter name.
 func GetMaxTextureSize() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_TEXTURE_SIZE, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_TEXTURE_SIZE, data[:])
+	return data[0]
 }
 
 // GetMaxVaryingVectors returns a value for the passed parameter name.
 func GetMaxVaryingVectors() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_VARYING_VECTORS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_VARYING_VECTORS, data[:])
+	return data[0]
 }
 
 // GetMaxVertexAttribs returns a value for the passed parameter name.
 func GetMaxVertexAttribs() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_VERTEX_ATTRIBS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_VERTEX_ATTRIBS, data[:])
+	return data[0]
 }
 
 // GetMaxVertexTextureImageUnits returns a value for the passed parameter name.
 func GetMaxVertexTextureImageUnits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_VERTEX_TEXTURE_IMAGE_UNITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_VERTEX_TEXTURE_IMAGE_UNITS, data[:])
+	return data[0]
 }
 
 // GetMaxVertexUniformVectors returns a value for the passed parameter name.
 func GetMaxVertexUniformVectors() int32 {
-	var data int32
-	gl.GetIntegerv(gl.MAX_VERTEX_UNIFORM_VECTORS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.MAX_VERTEX_UNIFORM_VECTORS, data[:])
+	return data[0]
 }
 
 // GetMaxViewportDims returns a value for the passed parameter name.
 func GetMaxViewportDims() [2]int32 {
 	var data [2]int32
-	gl.GetIntegerv(gl.MAX_VIEWPORT_DIMS, &data[0])
+	backend.GetIntegerv(gl.MAX_VIEWPORT_DIMS, data[:])
 	return data
 }
 
 // GetPackAlignment returns a value for the passed parameter name.
 func GetPackAlignment() int32 {
-	var data int32
-	gl.GetIntegerv(gl.PACK_ALIGNMENT, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.PACK_ALIGNMENT, data[:])
+	return data[0]
 }
 
 // GetPolygonOffsetFactor returns a value for the passed parameter name.
 func GetPolygonOffsetFactor() float32 {
-	var data float32
-	gl.GetFloatv(gl.POLYGON_OFFSET_FACTOR, &data)
-	return data
+	var data [1]float32
+	backend.GetFloatv(gl.POLYGON_OFFSET_FACTOR, data[:])
+	return data[0]
 }
 
 // GetPolygonOffsetFill returns a value for the passed parameter name.
 func GetPolygonOffsetFill() bool {
-	var data bool
-	gl.GetBooleanv(gl.POLYGON_OFFSET_FILL, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.POLYGON_OFFSET_FILL, data[:])
+	return data[0]
 }
 
 // GetPolygonOffsetUnits returns a value for the passed parameter name.
 func GetPolygonOffsetUnits() float32 {
-	var data float32
-	gl.GetFloatv(gl.POLYGON_OFFSET_UNITS, &data)
-	return data
+	var data [1]float32
+	backend.GetFloatv(gl.POLYGON_OFFSET_UNITS, data[:])
+	return data[0]
+}
+
+// GetReadBuffer returns a value for the passed parameter name.
+func GetReadBuffer() GLEnum {
+	var data [1]int32
+	backend.GetIntegerv(gl.READ_BUFFER, data[:])
+	return GLEnum(data[0])
+}
+
+// GetReadFramebufferBinding returns a value for the passed parameter name.
+func GetReadFramebufferBinding() Framebuffer {
+	var data [1]int32
+	backend.GetIntegerv(gl.READ_FRAMEBUFFER_BINDING, data[:])
+	return Framebuffer(data[0])
 }
 
 // GetRedBits returns a value for the passed parameter name.
 func GetRedBits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.RED_BITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.RED_BITS, data[:])
+	return data[0]
 }
 
 // GetRenderbufferBinding returns a value for the passed parameter name.
 func GetRenderbufferBinding() Renderbuffer {
-	var data int32
-	gl.GetIntegerv(gl.RENDERBUFFER_BINDING, &data)
-	return Renderbuffer(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.RENDERBUFFER_BINDING, data[:])
+	return Renderbuffer(data[0])
 }
 
 // TODO: GetRenderer
 
 // GetSampleBuffers returns a value for the passed parameter name.
 func GetSampleBuffers() int32 {
-	var data int32
-	gl.GetIntegerv(gl.SAMPLE_BUFFERS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.SAMPLE_BUFFERS, data[:])
+	return data[0]
 }
 
 // GetSampleCoverageInvert returns a value for the passed parameter name.
 func GetSampleCoverageInvert() bool {
-	var data bool
-	gl.GetBooleanv(gl.SAMPLE_COVERAGE_INVERT, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.SAMPLE_COVERAGE_INVERT, data[:])
+	return data[0]
 }
 
 // GetSampleCoverageValue returns a value for the passed parameter name.
 func GetSampleCoverageValue() float32 {
-	var data float32
-	gl.GetFloatv(gl.SAMPLE_COVERAGE_VALUE, &data)
-	return data
+	var data [1]float32
+	backend.GetFloatv(gl.SAMPLE_COVERAGE_VALUE, data[:])
+	return data[0]
 }
 
 // GetSamples returns a value for the passed parameter name.
 func GetSamples() int32 {
-	var data int32
-	gl.GetIntegerv(gl.SAMPLES, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.SAMPLES, data[:])
+	return data[0]
 }
 
 // GetScissorBox returns a value for the passed parameter name.
 func GetScissorBox() [4]int32 {
 	var data [4]int32
-	gl.GetIntegerv(gl.SCISSOR_BOX, &data[0])
+	backend.GetIntegerv(gl.SCISSOR_BOX, data[:])
 	return data
 }
 
 // GetScissorTest returns a value for the passed parameter name.
 func GetScissorTest() bool {
-	var data bool
-	gl.GetBooleanv(gl.SCISSOR_TEST, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.SCISSOR_TEST, data[:])
+	return data[0]
 }
 
 // TODO: GetShadingLanguageVersion
 
 // GetStencilBackFail returns a value for the passed parameter name.
 func GetStencilBackFail() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BACK_FAIL, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BACK_FAIL, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilBackFunc returns a value for the passed parameter name.
 func GetStencilBackFunc() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BACK_FUNC, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BACK_FUNC, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilBackPassDepthFail returns a value for the passed parameter name.
 func GetStencilBackPassDepthFail() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BACK_PASS_DEPTH_FAIL, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BACK_PASS_DEPTH_FAIL, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilBackPassDepthPass returns a value for the passed parameter name.
 func GetStencilBackPassDepthPass() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BACK_PASS_DEPTH_PASS, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BACK_PASS_DEPTH_PASS, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilBackRef returns a value for the passed parameter name.
 func GetStencilBackRef() int32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BACK_REF, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BACK_REF, data[:])
+	return data[0]
 }
 
 // GetStencilBackValueMask returns a value for the passed parameter name.
 func GetStencilBackValueMask() uint32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BACK_VALUE_MASK, &data)
-	return uint32(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BACK_VALUE_MASK, data[:])
+	return uint32(data[0])
 }
 
 // GetStencilBackWritemask returns a value for the passed parameter name.
 func GetStencilBackWritemask() uint32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BACK_WRITEMASK, &data)
-	return uint32(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BACK_WRITEMASK, data[:])
+	return uint32(data[0])
 }
 
 // GetStencilBits returns a value for the passed parameter name.
 func GetStencilBits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_BITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_BITS, data[:])
+	return data[0]
 }
 
 // GetStencilClearValue returns a value for the passed parameter name.
 func GetStencilClearValue() int32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_CLEAR_VALUE, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_CLEAR_VALUE, data[:])
+	return data[0]
 }
 
 // GetStencilFail returns a value for the passed parameter name.
 func GetStencilFail() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_FAIL, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_FAIL, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilFunc returns a value for the passed parameter name.
 func GetStencilFunc() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_FUNC, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_FUNC, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilPassDepthFail returns a value for the passed parameter name.
 func GetStencilPassDepthFail() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_PASS_DEPTH_FAIL, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_PASS_DEPTH_FAIL, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilPassDepthPass returns a value for the passed parameter name.
 func GetStencilPassDepthPass() GLEnum {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_PASS_DEPTH_PASS, &data)
-	return GLEnum(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_PASS_DEPTH_PASS, data[:])
+	return GLEnum(data[0])
 }
 
 // GetStencilRef returns a value for the passed parameter name.
 func GetStencilRef() int32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_REF, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_REF, data[:])
+	return data[0]
 }
 
 // GetStencilTest returns a value for the passed parameter name.
 func GetStencilTest() bool {
-	var data bool
-	gl.GetBooleanv(gl.STENCIL_TEST, &data)
-	return data
+	var data [1]bool
+	backend.GetBooleanv(gl.STENCIL_TEST, data[:])
+	return data[0]
 }
 
 // GetStencilValueMask returns a value for the passed parameter name.
 func GetStencilValueMask() uint32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_VALUE_MASK, &data)
-	return uint32(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_VALUE_MASK, data[:])
+	return uint32(data[0])
 }
 
 // GetStencilWritemask returns a value for the passed parameter name.
 func GetStencilWritemask() uint32 {
-	var data int32
-	gl.GetIntegerv(gl.STENCIL_WRITEMASK, &data)
-	return uint32(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.STENCIL_WRITEMASK, data[:])
+	return uint32(data[0])
 }
 
 // GetSubpixelBits returns a value for the passed parameter name.
 func GetSubpixelBits() int32 {
-	var data int32
-	gl.GetIntegerv(gl.SUBPIXEL_BITS, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.SUBPIXEL_BITS, data[:])
+	return data[0]
 }
 
 // GetTextureBinding2D returns a value for the passed parameter name.
 func GetTextureBinding2D() Texture {
-	var data int32
-	gl.GetIntegerv(gl.TEXTURE_BINDING_2D, &data)
-	return Texture(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.TEXTURE_BINDING_2D, data[:])
+	return Texture(data[0])
 }
 
 // GetTextureBindingCubeMap returns a value for the passed parameter name.
 func GetTextureBindingCubeMap() Texture {
-	var data int32
-	gl.GetIntegerv(gl.TEXTURE_BINDING_CUBE_MAP, &data)
-	return Texture(data)
+	var data [1]int32
+	backend.GetIntegerv(gl.TEXTURE_BINDING_CUBE_MAP, data[:])
+	return Texture(data[0])
 }
 
 // GetUnpackAlignment returns a value for the passed parameter name.
 func GetUnpackAlignment() int32 {
-	var data int32
-	gl.GetIntegerv(gl.UNPACK_ALIGNMENT, &data)
-	return data
+	var data [1]int32
+	backend.GetIntegerv(gl.UNPACK_ALIGNMENT, data[:])
+	return data[0]
 }
 
 // TODO: GetVendor
@@ -677,36 +727,41 @@ func GetUnpackAlignment() int32 {
 // GetViewport returns a value for the passed parameter name.
 func GetViewport() [4]int32 {
 	var data [4]int32
-	gl.GetIntegerv(gl.VIEWPORT, &data[0])
+	backend.GetIntegerv(gl.VIEWPORT, data[:])
 	return data
 }
 
 // GetError returns error information.
+//
+// CheckError returns the same information as an error.
 func GetError() GLEnum {
-	return GLEnum(gl.GetError())
+	return backend.GetError()
 }
 
 // Hint specifies hints for certain behaviors. The interpretation of these hints
 // depend on the implementation.
 func Hint(target, mode GLEnum) {
-	gl.Hint(uint32(target), uint32(mode))
+	backend.Hint(target, mode)
 }
 
 // IsEnabled tests whether a specific OpenGL capability is enabled or not.
 //
 // By default, all capabilities except GLDither are disabled.
 func IsEnabled(cap GLEnum) bool {
-	return gl.IsEnabled(uint32(cap))
+	return backend.IsEnabled(cap)
 }
 
 // LineWidth sets the line width of rasterized lines.
 func LineWidth(width float32) {
-	gl.LineWidth(width)
+	backend.LineWidth(width)
 }
 
 // PixelStorei specifies the pixel storage modes.
 func PixelStorei(pname GLEnum, param int32) {
-	gl.PixelStorei(uint32(pname), param)
+	backend.PixelStorei(pname, param)
+	if pname == GLUnpackAlignment && (param == 1 || param == 2 || param == 4 || param == 8) {
+		unpackAlignment = param
+	}
 }
 
 // PolygonOffset specifies the scale factors and units to calculate depth
@@ -715,13 +770,13 @@ func PixelStorei(pname GLEnum, param int32) {
 // The offset is added before the depth test is performed and before the value
 // is written into the depth buffer.
 func PolygonOffset(factor, units float32) {
-	gl.PolygonOffset(factor, units)
+	backend.PolygonOffset(factor, units)
 }
 
 // SampleCoverage specifies multi-sample coverage parameters for anti-aliasing
 // effects.
 func SampleCoverage(value float32, invert bool) {
-	gl.SampleCoverage(value, invert)
+	backend.SampleCoverage(value, invert)
 }
 
 // StencilFunc sets the front and back function and reference value for stencil
@@ -730,7 +785,7 @@ func SampleCoverage(value float32, invert bool) {
 // Stenciling enables and disables drawing on a per-pixel basis. It is typically
 // used in multipass rendering to achieve special effects.
 func StencilFunc(xfunc GLEnum, ref int32, mask uint32) {
-	gl.StencilFunc(uint32(xfunc), ref, mask)
+	backend.StencilFunc(xfunc, ref, mask)
 }
 
 // StencilFuncSeparate sets the front and/or back function and reference value
@@ -739,7 +794,7 @@ func StencilFunc(xfunc GLEnum, ref int32, mask uint32) {
 // Stenciling enables and disables drawing on a per-pixel basis. It is typically
 // used in multipass rendering to achieve special effects.
 func StencilFuncSeparate(face, xfunc GLEnum, ref int32, mask uint32) {
-	gl.StencilFuncSeparate(uint32(face), uint32(xfunc), ref, mask)
+	backend.StencilFuncSeparate(face, xfunc, ref, mask)
 }
 
 // StencilMask controls enabling and disabling of both the front and back
@@ -748,7 +803,7 @@ func StencilFuncSeparate(face, xfunc GLEnum, ref int32, mask uint32) {
 // The StencilMaskSeparate function can set front and back stencil writemasks to
 // different values.
 func StencilMask(mask GLEnum) {
-	gl.StencilMask(uint32(mask))
+	backend.StencilMask(uint32(mask))
 }
 
 // StencilMaskSeparate controls enabling and disabling of front and/or back
@@ -757,15 +812,15 @@ func StencilMask(mask GLEnum) {
 // The StencilMask function can set both, the front and back stencil writemasks
 // to one value at the same time.
 func StencilMaskSeparate(face GLEnum, mask uint32) {
-	gl.StencilMaskSeparate(uint32(face), mask)
+	backend.StencilMaskSeparate(face, mask)
 }
 
 // StencilOp sets both the front and back-facing stencil test actions.
 func StencilOp(fail, zfail, zpass GLEnum) {
-	gl.StencilOp(uint32(fail), uint32(zfail), uint32(zpass))
+	backend.StencilOp(fail, zfail, zpass)
 }
 
 // StencilOpSeparate sets the front and/or back-facing stencil test actions.
 func StencilOpSeparate(face, fail, zfail, zpass GLEnum) {
-	gl.StencilOpSeparate(uint32(face), uint32(fail), uint32(zfail), uint32(zpass))
+	backend.StencilOpSeparate(face, fail, zfail, zpass)
 }
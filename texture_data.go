@@ -0,0 +1,259 @@
+package gogl
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// maxTextureDataSize is the maximum width and height of a TextureData that is
+// parsed or decompressed, which keeps the sizes computed from the headers of
+// crafted files from overflowing.
+const maxTextureDataSize = 16384
+
+// TextureData is a texture with all of its mipmap levels and cube map faces,
+// as parsed from a container file by ParseDDS or ParseKTX.
+//
+// The images are stored in the row order of the file. DDS and KTX2 files
+// store the top row first, whereas OpenGL expects the bottom row first, so
+// the texture coordinate t of such textures needs to be flipped.
+type TextureData struct {
+	// Target is GLTexture2D, or GLTextureCubeMap for cube maps.
+	Target GLEnum
+	// InternalFormat is the internal format of the texture, e.g.,
+	// GLCompressedRGBAS3TCDXT5 or GLRGBA.
+	InternalFormat GLEnum
+	// Format and Type are the format and type of the pixels of uncompressed
+	// textures, e.g., GLRGBA and GLUInt8. Both are 0 for compressed textures.
+	Format, Type GLEnum
+	// Width and Height are the dimensions of the mipmap level 0.
+	Width, Height int
+	// Alignment is the alignment of the rows of uncompressed pixels, as set
+	// with GLUnpackAlignment.
+	Alignment int32
+	// Images are the images of every mipmap level and cube map face, ordered
+	// by level first.
+	Images []TextureImage
+}
+
+// TextureImage is an image of a single mipmap level and cube map face of a
+// TextureData.
+type TextureImage struct {
+	// Level is the mipmap level of the image.
+	Level int
+	// Face is the index of the cube map face of the image in the order of the
+	// constants GLTextureCubeMapPositiveX to GLTextureCubeMapNegativeZ, or 0
+	// for 2D textures.
+	Face int
+	// Width and Height are the dimensions of the image.
+	Width, Height int
+	// Data holds the compressed blocks or the pixels of the image.
+	Data []byte
+}
+
+// Compressed reports whether the texture is stored in a compressed format.
+func (data *TextureData) Compressed() bool {
+	return data.Format == 0
+}
+
+// Levels returns the number of mipmap levels of the texture.
+func (data *TextureData) Levels() int {
+	levels := 0
+	for _, image := range data.Images {
+		if image.Level >= levels {
+			levels = image.Level + 1
+		}
+	}
+	return levels
+}
+
+// Upload creates a Texture and uploads every mipmap level and cube map face
+// to it. The Texture is left bound to data.Target. It uses linear filtering,
+// between mipmaps too if there are several levels, and cube maps clamp their
+// texture coordinates to the edges.
+//
+// Compressed formats require extensions, which are checked with
+// HasExtension: GL_EXT_texture_compression_s3tc for DXT1, DXT3 and DXT5 (and
+// GL_EXT_texture_sRGB for their sRGB variants), OpenGL 3.0 or
+// GL_ARB_texture_compression_rgtc for BC4 and BC5, and OpenGL 4.2 or
+// GL_ARB_texture_compression_bptc for BC7. If the extension for DXT1, DXT3 or
+// DXT5 is missing, the images are decompressed on the CPU and uploaded as
+// GLRGBA instead, which requires their dimensions to be at most 16384.
+// Otherwise, Upload returns an error if the format is not
+// supported.
+//
+// Uncompressed images must hold the rows of their pixels aligned to
+// data.Alignment, in a format and type known to PixelSize. Upload returns an
+// error otherwise, without creating a Texture.
+func (data *TextureData) Upload() (Texture, error) {
+	if err := data.checkImages(); err != nil {
+		return 0, fmt.Errorf("gogl: uploading texture: %w", err)
+	}
+	decompress := false
+	if data.Compressed() {
+		if requirement, ok := compressedFormatSupported(data.InternalFormat); !ok {
+			if !isDXT(data.InternalFormat) {
+				return 0, fmt.Errorf("gogl: uploading %v requires %s", data.InternalFormat, requirement)
+			}
+			decompress = true
+		}
+	}
+	if decompress {
+		for _, image := range data.Images {
+			if err := checkTextureDataSize(image.Width, image.Height, 1); err != nil {
+				return 0, fmt.Errorf("gogl: decompressing %v: %w", data.InternalFormat, err)
+			}
+		}
+	}
+
+	texture := CreateTexture()
+	BindTexture(data.Target, texture)
+	if !data.Compressed() || decompress {
+		alignment := data.Alignment
+		if decompress {
+			alignment = 4
+		}
+		previous := unpackAlignment
+		PixelStorei(GLUnpackAlignment, alignment)
+		defer PixelStorei(GLUnpackAlignment, previous)
+	}
+
+	for _, image := range data.Images {
+		target := data.Target
+		if target == GLTextureCubeMap {
+			target = GLTextureCubeMapPositiveX + GLEnum(image.Face)
+		}
+		level, width, height := int32(image.Level), int32(image.Width), int32(image.Height)
+		switch {
+		case decompress:
+			internalformat := GLRGBA
+			if isSRGB(data.InternalFormat) {
+				internalformat = GLSRGB8Alpha8
+			}
+			pixels := decompressDXT(data.InternalFormat, image.Width, image.Height, image.Data)
+			backend.TexImage2D(target, level, internalformat, width, height, 0, GLRGBA, GLUInt8, slicePointer(pixels))
+		case data.Compressed():
+			CompressedTexImage2D(target, level, data.InternalFormat, width, height, 0, image.Data)
+		default:
+			backend.TexImage2D(target, level, data.InternalFormat, width, height, 0, data.Format, data.Type, slicePointer(image.Data))
+		}
+	}
+
+	levels := data.Levels()
+	TexParameteri(data.Target, GLTextureMaxLevel, int32(levels-1))
+	if levels > 1 {
+		TexParameteri(data.Target, GLTextureMinFilter, int32(GLLinearMipmapLinear))
+	} else {
+		TexParameteri(data.Target, GLTextureMinFilter, int32(GLLinear))
+	}
+	TexParameteri(data.Target, GLTextureMagFilter, int32(GLLinear))
+	if data.Target == GLTextureCubeMap {
+		TexParameteri(data.Target, GLTextureWrapS, int32(GLClampToEdge))
+		TexParameteri(data.Target, GLTextureWrapT, int32(GLClampToEdge))
+	}
+	return texture, nil
+}
+
+// checkImages returns an error if the texture is uncompressed, and its format
+// and type are not known to PixelSize, or one of its images holds fewer bytes
+// than OpenGL reads for it.
+func (data *TextureData) checkImages() error {
+	if data.Compressed() {
+		return nil
+	}
+	if PixelSize(data.Format, data.Type) == 0 {
+		return fmt.Errorf("unsupported format %v and type %v", data.Format, data.Type)
+	}
+	for _, image := range data.Images {
+		if err := checkTextureDataSize(image.Width, image.Height, 1); err != nil {
+			return fmt.Errorf("level %d, face %d: %w", image.Level, image.Face, err)
+		}
+		required := imageDataSize("TextureData.Upload", int32(image.Width), int32(image.Height), data.Format, data.Type, data.Alignment)
+		if len(image.Data) < required {
+			return fmt.Errorf("level %d, face %d: %d bytes are too few for %dx%d pixels, which need %d",
+				image.Level, image.Face, len(image.Data), image.Width, image.Height, required)
+		}
+	}
+	return nil
+}
+
+// compressedFormatSupported reports whether the context supports the
+// compressed format, and returns the requirement of the format for errors.
+func compressedFormatSupported(format GLEnum) (requirement string, ok bool) {
+	switch format {
+	case GLCompressedRGBS3TCDXT1, GLCompressedRGBAS3TCDXT1, GLCompressedRGBAS3TCDXT3, GLCompressedRGBAS3TCDXT5:
+		return "GL_EXT_texture_compression_s3tc", HasExtension("GL_EXT_texture_compression_s3tc")
+	case GLCompressedSRGBS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT3, GLCompressedSRGBAlphaS3TCDXT5:
+		return "GL_EXT_texture_compression_s3tc and GL_EXT_texture_sRGB",
+			HasExtension("GL_EXT_texture_compression_s3tc") && HasExtension("GL_EXT_texture_sRGB")
+	case GLCompressedRedRGTC1, GLCompressedSignedRedRGTC1, GLCompressedRGRGTC2, GLCompressedSignedRGRGTC2:
+		return "OpenGL 3.0 or GL_ARB_texture_compression_rgtc",
+			versionAtLeast(3, 0) || HasExtension("GL_ARB_texture_compression_rgtc") || HasExtension("GL_EXT_texture_compression_rgtc")
+	case GLCompressedRGBABPTCUnorm, GLCompressedSRGBAlphaBPTCUnorm:
+		return "OpenGL 4.2 or GL_ARB_texture_compression_bptc",
+			versionAtLeast(4, 2) || HasExtension("GL_ARB_texture_compression_bptc")
+	}
+	return "a compressed format known to gogl", false
+}
+
+// isDXT reports whether the format is one of the DXT1, DXT3 or DXT5 formats,
+// which can be decompressed by decompressDXT.
+func isDXT(format GLEnum) bool {
+	switch format {
+	case GLCompressedRGBS3TCDXT1, GLCompressedRGBAS3TCDXT1, GLCompressedRGBAS3TCDXT3, GLCompressedRGBAS3TCDXT5,
+		GLCompressedSRGBS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT3, GLCompressedSRGBAlphaS3TCDXT5:
+		return true
+	}
+	return false
+}
+
+// isSRGB reports whether the compressed format stores sRGB colors.
+func isSRGB(format GLEnum) bool {
+	switch format {
+	case GLCompressedSRGBS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT3, GLCompressedSRGBAlphaS3TCDXT5,
+		GLCompressedSRGBAlphaBPTCUnorm:
+		return true
+	}
+	return false
+}
+
+// blockSize returns the size in bytes of a 4x4 block of the compressed format.
+func blockSize(format GLEnum) int {
+	switch format {
+	case GLCompressedRGBS3TCDXT1, GLCompressedRGBAS3TCDXT1, GLCompressedSRGBS3TCDXT1, GLCompressedSRGBAlphaS3TCDXT1,
+		GLCompressedRedRGTC1, GLCompressedSignedRedRGTC1:
+		return 8
+	}
+	return 16
+}
+
+// compressedImageSize returns the size in bytes of an image of the given
+// dimensions in the compressed format.
+func compressedImageSize(format GLEnum, width, height int) int {
+	return (width + 3) / 4 * ((height + 3) / 4) * blockSize(format)
+}
+
+// checkTextureDataSize returns an error if the dimensions of the mipmap level 0
+// are empty or exceed maxTextureDataSize, or if there are more mipmap levels
+// than a texture of these dimensions has.
+func checkTextureDataSize(width, height, levels int) error {
+	if width <= 0 || height <= 0 || width > maxTextureDataSize || height > maxTextureDataSize {
+		return fmt.Errorf("invalid size %dx%d", width, height)
+	}
+	largest := width
+	if height > largest {
+		largest = height
+	}
+	if max := bits.Len(uint(largest)); levels > max {
+		return fmt.Errorf("%d mipmap levels exceed the maximum of %d for size %dx%d", levels, max, width, height)
+	}
+	return nil
+}
+
+// mipmapSize returns the size of a dimension at the mipmap level.
+func mipmapSize(size, level int) int {
+	size >>= level
+	if size < 1 {
+		return 1
+	}
+	return size
+}
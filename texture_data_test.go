@@ -0,0 +1,82 @@
+package gogl_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestTextureDataUpload(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	data := &gogl.TextureData{
+		Target:         gogl.GLTexture2D,
+		InternalFormat: gogl.GLRGB,
+		Format:         gogl.GLRGB,
+		Type:           gogl.GLUInt8,
+		Width:          2,
+		Height:         2,
+		Alignment:      1,
+		Images: []gogl.TextureImage{
+			{Level: 0, Width: 2, Height: 2, Data: make([]byte, 12)},
+			{Level: 1, Width: 1, Height: 1, Data: make([]byte, 3)},
+		},
+	}
+	texture, err := data.Upload()
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	want := []string{
+		"CreateTexture()",
+		fmt.Sprintf("BindTexture(GL_TEXTURE_2D, %d)", texture),
+		"PixelStorei(GL_UNPACK_ALIGNMENT, 1)",
+		"TexImage2D(GL_TEXTURE_2D, 0, GL_RGB, 2, 2, 0, GL_RGB, GL_UNSIGNED_BYTE)",
+		"TexImage2D(GL_TEXTURE_2D, 1, GL_RGB, 1, 1, 0, GL_RGB, GL_UNSIGNED_BYTE)",
+		"TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAX_LEVEL, 1)",
+		fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, %d)", gogl.GLLinearMipmapLinear),
+		fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, %d)", gogl.GLLinear),
+		"PixelStorei(GL_UNPACK_ALIGNMENT, 4)",
+	}
+	if got := callStrings(b.Calls); !reflect.DeepEqual(got, want) {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
+
+func TestTextureDataUploadInvalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		format, xtype gogl.GLEnum
+		alignment     int32
+		data          []byte
+		err           string
+	}{
+		{"truncated", gogl.GLRGB, gogl.GLUInt8, 4, make([]byte, 13), "level 0, face 0: 13 bytes are too few for 2x2 pixels, which need 14"},
+		{"truncated tightly packed", gogl.GLRGBA, gogl.GLFloat32, 1, make([]byte, 63), "level 0, face 0: 63 bytes are too few for 2x2 pixels, which need 64"},
+		{"unknown type", gogl.GLRGBA, 0x140B, 4, make([]byte, 32), "unsupported format GL_RGBA and type"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			data := &gogl.TextureData{
+				Target:         gogl.GLTexture2D,
+				InternalFormat: gogl.GLRGBA,
+				Format:         test.format,
+				Type:           test.xtype,
+				Width:          2,
+				Height:         2,
+				Alignment:      test.alignment,
+				Images:         []gogl.TextureImage{{Width: 2, Height: 2, Data: test.data}},
+			}
+			_, err := data.Upload()
+			if err == nil || !strings.HasPrefix(err.Error(), "gogl: uploading texture: "+test.err) {
+				t.Errorf("Upload() error = %v, want %q", err, test.err)
+			}
+			if len(b.Calls) != 0 {
+				t.Errorf("calls = %v, want none", b.Calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package gogl
+
+import (
+	"image"
+	"image/draw"
+)
+
+// TexImage2DFromImage specifies a two-dimensional texture image with the
+// contents of img.
+//
+// The rows of img are flipped, so that its top row ends up at the top of the
+// texture, where the texture coordinate t is 1. The rows are aligned to the
+// GLUnpackAlignment set with PixelStorei, so the alignment does not need to be
+// changed.
+//
+// *image.RGBA and *image.NRGBA are uploaded as GLRGBA without conversion, so
+// the colors of *image.RGBA keep their premultiplied alpha. *image.Gray is
+// uploaded as GLLuminance. Every other image, e.g., *image.YCbCr or
+// *image.Paletted, is converted to non-premultiplied GLRGBA first.
+func TexImage2DFromImage(target GLEnum, level int32, img image.Image) {
+	pixels, format, width, height := imageTexData("TexImage2DFromImage", img)
+	backend.TexImage2D(target, level, format, width, height, 0, format, GLUInt8, slicePointer(pixels))
+}
+
+// TexSubImage2DFromImage specifies a sub-rectangle of the current texture with
+// the contents of img, converted and flipped as by TexImage2DFromImage. The
+// bottom left corner of img is placed at xoffset, yoffset.
+//
+// The format of the texture must match the format img is uploaded in, i.e.,
+// GLLuminance for *image.Gray, and GLRGBA otherwise.
+func TexSubImage2DFromImage(target GLEnum, level, xoffset, yoffset int32, img image.Image) {
+	pixels, format, width, height := imageTexData("TexSubImage2DFromImage", img)
+	if len(pixels) == 0 {
+		return
+	}
+	backend.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, GLUInt8, slicePointer(pixels))
+}
+
+// imageTexData returns the pixels of img in bottom-up order with rows aligned
+// to the unpack alignment, and their format. The type of the pixels is always
+// GLUInt8.
+func imageTexData(function string, img image.Image) (pixels []byte, format GLEnum, width, height int32) {
+	return imageRows(function, img, true)
+}
+
+// imageRows is like imageTexData, but keeps the top-down order of the rows of
+// img unless bottomUp is set.
+func imageRows(function string, img image.Image, bottomUp bool) (pixels []byte, format GLEnum, width, height int32) {
+	bounds := img.Bounds()
+	var pix []byte
+	var stride int
+	switch img := img.(type) {
+	case *image.RGBA:
+		pix, stride, format = img.Pix[img.PixOffset(bounds.Min.X, bounds.Min.Y):], img.Stride, GLRGBA
+	case *image.NRGBA:
+		pix, stride, format = img.Pix[img.PixOffset(bounds.Min.X, bounds.Min.Y):], img.Stride, GLRGBA
+	case *image.Gray:
+		pix, stride, format = img.Pix[img.PixOffset(bounds.Min.X, bounds.Min.Y):], img.Stride, GLLuminance
+	default:
+		converted := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		draw.Draw(converted, converted.Bounds(), img, bounds.Min, draw.Src)
+		pix, stride, format = converted.Pix, converted.Stride, GLRGBA
+	}
+
+	width, height = int32(bounds.Dx()), int32(bounds.Dy())
+	if width <= 0 || height <= 0 {
+		return nil, format, width, height
+	}
+	row, alignedStride := imageLayout(function, width, format, GLUInt8, unpackAlignment)
+	pixels = make([]byte, imageDataSize(function, width, height, format, GLUInt8, unpackAlignment))
+	for y := 0; y < int(height); y++ {
+		target := y
+		if bottomUp {
+			target = int(height) - 1 - y
+		}
+		copy(pixels[target*alignedStride:], pix[y*stride:y*stride+row])
+	}
+	return pixels, format, width, height
+}
@@ -0,0 +1,134 @@
+package gogl_test
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+	"github.com/pegasus-toolset/gogl/gogltest"
+)
+
+func TestTexImage2DFromImage(t *testing.T) {
+	gray := image.NewGray(image.Rect(0, 0, 3, 2))
+	copy(gray.Pix, []byte{1, 2, 3, 4, 5, 6})
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 1, 2))
+	copy(nrgba.Pix, []byte{10, 20, 30, 128, 40, 50, 60, 255})
+	rgba := image.NewRGBA(image.Rect(0, 0, 3, 3))
+	for i := range rgba.Pix {
+		rgba.Pix[i] = byte(i)
+	}
+	paletted := image.NewPaletted(image.Rect(0, 0, 2, 1), color.Palette{color.NRGBA{255, 0, 0, 255}, color.NRGBA{0, 0, 255, 128}})
+	paletted.Pix = []byte{1, 0}
+
+	tests := []struct {
+		name      string
+		img       image.Image
+		alignment int32
+		want      string
+		data      []byte
+	}{
+		{
+			"Gray", gray, 4,
+			"TexImage2D(GL_TEXTURE_2D, 0, GL_LUMINANCE, 3, 2, 0, GL_LUMINANCE, GL_UNSIGNED_BYTE)",
+			[]byte{4, 5, 6, 0, 1, 2, 3},
+		},
+		{
+			"Gray tightly packed", gray, 1,
+			"TexImage2D(GL_TEXTURE_2D, 0, GL_LUMINANCE, 3, 2, 0, GL_LUMINANCE, GL_UNSIGNED_BYTE)",
+			[]byte{4, 5, 6, 1, 2, 3},
+		},
+		{
+			"NRGBA", nrgba, 4,
+			"TexImage2D(GL_TEXTURE_2D, 0, GL_RGBA, 1, 2, 0, GL_RGBA, GL_UNSIGNED_BYTE)",
+			[]byte{40, 50, 60, 255, 10, 20, 30, 128},
+		},
+		{
+			"RGBA sub-image", rgba.SubImage(image.Rect(1, 1, 3, 3)), 8,
+			"TexImage2D(GL_TEXTURE_2D, 0, GL_RGBA, 2, 2, 0, GL_RGBA, GL_UNSIGNED_BYTE)",
+			[]byte{28, 29, 30, 31, 32, 33, 34, 35, 16, 17, 18, 19, 20, 21, 22, 23},
+		},
+		{
+			"Paletted", paletted, 4,
+			"TexImage2D(GL_TEXTURE_2D, 0, GL_RGBA, 2, 1, 0, GL_RGBA, GL_UNSIGNED_BYTE)",
+			[]byte{0, 0, 255, 128, 255, 0, 0, 255},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			gogl.PixelStorei(gogl.GLUnpackAlignment, test.alignment)
+			b.ClearCalls()
+
+			gogl.TexImage2DFromImage(gogl.GLTexture2D, 0, test.img)
+
+			if len(b.Calls) != 1 {
+				t.Fatalf("calls = %v, want 1 call", b.Calls)
+			}
+			if got := b.Calls[0].String(); got != test.want {
+				t.Errorf("call = %s, want %s", got, test.want)
+			}
+			if got := b.Calls[0].Data; !reflect.DeepEqual(got, test.data) {
+				t.Errorf("data = %v, want %v", got, test.data)
+			}
+		})
+	}
+}
+
+func TestTexSubImage2DFromImage(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	gray := image.NewGray(image.Rect(0, 0, 1, 3))
+	copy(gray.Pix, []byte{1, 2, 3})
+
+	gogl.TexSubImage2DFromImage(gogl.GLTexture2D, 1, 4, 5, gray)
+	gogl.TexSubImage2DFromImage(gogl.GLTexture2D, 1, 4, 5, image.NewGray(image.Rect(0, 0, 0, 3)))
+
+	if len(b.Calls) != 1 {
+		t.Fatalf("calls = %v, want 1 call", b.Calls)
+	}
+	want := "TexSubImage2D(GL_TEXTURE_2D, 1, 4, 5, 1, 3, GL_LUMINANCE, GL_UNSIGNED_BYTE)"
+	if got := b.Calls[0].String(); got != want {
+		t.Errorf("call = %s, want %s", got, want)
+	}
+	if got, want := b.Calls[0].Data, []byte{3, 0, 0, 0, 2, 0, 0, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("data = %v, want %v", got, want)
+	}
+}
+
+func TestTexImage2DUnpackAlignment(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	pixels := make([]byte, 9)
+
+	gogl.PixelStorei(gogl.GLUnpackAlignment, 1)
+	gogl.TexImage2D(gogl.GLTexture2D, 0, gogl.GLLuminance, 3, 3, 0, gogl.GLLuminance, gogl.GLUInt8, pixels)
+	if got := b.CallsTo("GetIntegerv"); len(got) != 0 {
+		t.Errorf("GetIntegerv called %v, want no calls", got)
+	}
+
+	gogl.PixelStorei(gogl.GLUnpackAlignment, 4)
+	defer func() {
+		want := "gogl: TexImage2D: 9 bytes are too few for 3x3 pixels, which need 11"
+		if r := recover(); r != want {
+			t.Errorf("panic = %v, want %q", r, want)
+		}
+	}()
+	gogl.TexImage2D(gogl.GLTexture2D, 0, gogl.GLLuminance, 3, 3, 0, gogl.GLLuminance, gogl.GLUInt8, pixels)
+}
+
+func TestInitWithBackendQueriesUnpackAlignment(t *testing.T) {
+	initBackend(t, "3.3.0", "")
+	gogl.PixelStorei(gogl.GLUnpackAlignment, 1)
+
+	// A new Backend starts with the alignment of its context.
+	b := gogltest.NewBackend()
+	b.PixelStorei(gogl.GLUnpackAlignment, 2)
+	if err := gogl.InitWithBackend(b); err != nil {
+		t.Fatalf("InitWithBackend() error = %v", err)
+	}
+	b.ClearCalls()
+	gogl.TexImage2DFromImage(gogl.GLTexture2D, 0, image.NewGray(image.Rect(0, 0, 5, 2)))
+	if got, want := len(b.Calls[0].Data), 11; got != want {
+		t.Errorf("uploaded %d bytes, want %d", got, want)
+	}
+}
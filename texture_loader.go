@@ -0,0 +1,162 @@
+package gogl
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+	"io/fs"
+	"os"
+
+	// Register the decoders of the supported formats.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// TextureOptions are the options of the texture loaders, e.g., LoadTexture.
+// The zero value loads a texture with linear filtering and repeating texture
+// coordinates, without mipmaps.
+type TextureOptions struct {
+	// MinFilter is the minifying filter, e.g., GLLinear. It defaults to
+	// GLLinearMipmapLinear if Mipmaps is set, and to GLLinear otherwise.
+	MinFilter GLEnum
+	// MagFilter is the magnification filter, GLNearest or GLLinear. It
+	// defaults to GLLinear.
+	MagFilter GLEnum
+	// WrapS and WrapT are the wrap modes of the texture coordinates, e.g.,
+	// GLClampToEdge. They default to GLRepeat.
+	WrapS, WrapT GLEnum
+	// Mipmaps specifies whether mipmaps are generated with GenerateMipmap.
+	Mipmaps bool
+	// PremultiplyAlpha specifies whether the colors are multiplied by their
+	// alpha before uploading them. Otherwise, the colors are uploaded with
+	// non-premultiplied alpha.
+	PremultiplyAlpha bool
+	// SRGB specifies whether the colors are in sRGB color space, which is the
+	// case for most color textures. They are uploaded with an sRGB internal
+	// format, GLSRGB8Alpha8 or GLSLuminance8, so that sampling them returns
+	// linear colors.
+	SRGB bool
+	// SeamlessCubeMap specifies whether the cube map loaders, e.g.,
+	// NewCubeMap, enable GLTextureCubeMapSeamless if SeamlessCubeMapSupported
+	// reports it is supported. Seamless filtering is a global state that
+	// applies to all cube maps. It is ignored by the other loaders.
+	SeamlessCubeMap bool
+}
+
+// LoadTexture loads a PNG, JPEG or GIF image from the file at path into a new
+// Texture, and returns the Texture and the dimensions of the image. The
+// Texture is left bound to GLTexture2D.
+func LoadTexture(path string, options TextureOptions) (texture Texture, width, height int, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("gogl: loading texture: %w", err)
+	}
+	defer f.Close()
+	return loadTexture(path, f, options)
+}
+
+// LoadTextureFS is like LoadTexture, but loads the image from the file of the
+// given name in fsys, e.g., an embed.FS.
+func LoadTextureFS(fsys fs.FS, name string, options TextureOptions) (texture Texture, width, height int, err error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("gogl: loading texture: %w", err)
+	}
+	defer f.Close()
+	return loadTexture(name, f, options)
+}
+
+// LoadTextureReader is like LoadTexture, but decodes the image read from r.
+func LoadTextureReader(r io.Reader, options TextureOptions) (texture Texture, width, height int, err error) {
+	return loadTexture("image", r, options)
+}
+
+// loadTexture decodes the image read from r, which is called name in errors,
+// and loads it into a new Texture.
+func loadTexture(name string, r io.Reader, options TextureOptions) (texture Texture, width, height int, err error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("gogl: decoding texture %s: %w", name, err)
+	}
+	texture = NewTextureFromImage(img, options)
+	return texture, img.Bounds().Dx(), img.Bounds().Dy(), nil
+}
+
+// NewTextureFromImage loads img into a new Texture, like LoadTexture does
+// after decoding the image. The Texture is left bound to GLTexture2D.
+func NewTextureFromImage(img image.Image, options TextureOptions) Texture {
+	img = convertAlpha(img, options.PremultiplyAlpha)
+	pixels, format, width, height := imageTexData("NewTextureFromImage", img)
+	internalformat := format
+	if options.SRGB {
+		internalformat = GLSRGB8Alpha8
+		if format == GLLuminance {
+			internalformat = GLSLuminance8
+		}
+	}
+
+	texture := CreateTexture()
+	BindTexture(GLTexture2D, texture)
+	backend.TexImage2D(GLTexture2D, 0, internalformat, width, height, 0, format, GLUInt8, slicePointer(pixels))
+	if options.Mipmaps {
+		GenerateMipmap(GLTexture2D)
+	}
+	TexParameteri(GLTexture2D, GLTextureMinFilter, int32(options.minFilter()))
+	TexParameteri(GLTexture2D, GLTextureMagFilter, int32(orDefault(options.MagFilter, GLLinear)))
+	TexParameteri(GLTexture2D, GLTextureWrapS, int32(orDefault(options.WrapS, GLRepeat)))
+	TexParameteri(GLTexture2D, GLTextureWrapT, int32(orDefault(options.WrapT, GLRepeat)))
+	return texture
+}
+
+// minFilter returns the minifying filter of the options.
+func (options TextureOptions) minFilter() GLEnum {
+	if options.MinFilter != 0 {
+		return options.MinFilter
+	}
+	if options.Mipmaps {
+		return GLLinearMipmapLinear
+	}
+	return GLLinear
+}
+
+// convertAlpha converts img to an image with premultiplied or non-premultiplied
+// alpha, unless it already is one, or is opaque.
+func convertAlpha(img image.Image, premultiply bool) image.Image {
+	switch img := img.(type) {
+	case *image.Gray:
+		return img
+	case *image.RGBA:
+		if premultiply || img.Opaque() {
+			return img
+		}
+	case *image.NRGBA:
+		if !premultiply || img.Opaque() {
+			return img
+		}
+	default:
+		if !premultiply {
+			// imageTexData converts other images to non-premultiplied
+			// alpha.
+			return img
+		}
+	}
+	bounds := img.Bounds()
+	var converted draw.Image
+	if premultiply {
+		converted = image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	} else {
+		converted = image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	}
+	draw.Draw(converted, converted.Bounds(), img, bounds.Min, draw.Src)
+	return converted
+}
+
+// orDefault returns value, or def if value is 0.
+func orDefault(value, def GLEnum) GLEnum {
+	if value == 0 {
+		return def
+	}
+	return value
+}
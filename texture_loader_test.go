@@ -0,0 +1,162 @@
+package gogl_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+// encodePNG returns img encoded as PNG.
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+// translucentPNG returns a 2x1 PNG with a translucent red and an opaque blue
+// pixel.
+func translucentPNG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{200, 0, 0, 128})
+	img.SetNRGBA(1, 0, color.NRGBA{0, 0, 255, 255})
+	return encodePNG(t, img)
+}
+
+func TestLoadTextureReader(t *testing.T) {
+	gray := image.NewGray(image.Rect(0, 0, 1, 1))
+	tests := []struct {
+		name    string
+		data    func(t *testing.T) []byte
+		options gogl.TextureOptions
+		width   int
+		want    []string
+		pixels  []byte
+	}{
+		{
+			"default", translucentPNG, gogl.TextureOptions{}, 2,
+			[]string{
+				"CreateTexture()",
+				"BindTexture(GL_TEXTURE_2D, 1)",
+				"TexImage2D(GL_TEXTURE_2D, 0, GL_RGBA, 2, 1, 0, GL_RGBA, GL_UNSIGNED_BYTE)",
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, %d)", gogl.GLLinear),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, %d)", gogl.GLLinear),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_S, %d)", gogl.GLRepeat),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_T, %d)", gogl.GLRepeat),
+			},
+			[]byte{200, 0, 0, 128, 0, 0, 255, 255},
+		},
+		{
+			"sRGB mipmaps premultiplied", translucentPNG,
+			gogl.TextureOptions{SRGB: true, Mipmaps: true, PremultiplyAlpha: true, MagFilter: gogl.GLNearest, WrapS: gogl.GLClampToEdge}, 2,
+			[]string{
+				"CreateTexture()",
+				"BindTexture(GL_TEXTURE_2D, 1)",
+				"TexImage2D(GL_TEXTURE_2D, 0, GL_SRGB8_ALPHA8, 2, 1, 0, GL_RGBA, GL_UNSIGNED_BYTE)",
+				"GenerateMipmap(GL_TEXTURE_2D)",
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, %d)", gogl.GLLinearMipmapLinear),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, %d)", gogl.GLNearest),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_S, %d)", gogl.GLClampToEdge),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_T, %d)", gogl.GLRepeat),
+			},
+			[]byte{100, 0, 0, 128, 0, 0, 255, 255},
+		},
+		{
+			"sRGB gray", func(t *testing.T) []byte { return encodePNG(t, gray) }, gogl.TextureOptions{SRGB: true}, 1,
+			[]string{
+				"CreateTexture()",
+				"BindTexture(GL_TEXTURE_2D, 1)",
+				"TexImage2D(GL_TEXTURE_2D, 0, GL_SLUMINANCE8, 1, 1, 0, GL_LUMINANCE, GL_UNSIGNED_BYTE)",
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MIN_FILTER, %d)", gogl.GLLinear),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_MAG_FILTER, %d)", gogl.GLLinear),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_S, %d)", gogl.GLRepeat),
+				fmt.Sprintf("TexParameteri(GL_TEXTURE_2D, GL_TEXTURE_WRAP_T, %d)", gogl.GLRepeat),
+			},
+			[]byte{0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			texture, width, height, err := gogl.LoadTextureReader(bytes.NewReader(test.data(t)), test.options)
+			if err != nil {
+				t.Fatalf("LoadTextureReader() error = %v", err)
+			}
+			if texture != 1 || width != test.width || height != 1 {
+				t.Errorf("LoadTextureReader() = %d, %d, %d, want 1, %d, 1", texture, width, height, test.width)
+			}
+			if got := callStrings(b.Calls); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("calls = %q, want %q", got, test.want)
+			}
+			if got := b.CallsTo("TexImage2D")[0].Data; !reflect.DeepEqual(got, test.pixels) {
+				t.Errorf("pixels = %v, want %v", got, test.pixels)
+			}
+		})
+	}
+}
+
+func TestLoadTexture(t *testing.T) {
+	data := translucentPNG(t)
+	path := filepath.Join(t.TempDir(), "texture.png")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{"textures/texture.png": {Data: data}}
+
+	loaders := map[string]func() (gogl.Texture, int, int, error){
+		"LoadTexture": func() (gogl.Texture, int, int, error) {
+			return gogl.LoadTexture(path, gogl.TextureOptions{})
+		},
+		"LoadTextureFS": func() (gogl.Texture, int, int, error) {
+			return gogl.LoadTextureFS(fsys, "textures/texture.png", gogl.TextureOptions{})
+		},
+	}
+	for name, load := range loaders {
+		b := initBackend(t, "3.3.0", "")
+		texture, width, height, err := load()
+		if err != nil {
+			t.Fatalf("%s() error = %v", name, err)
+		}
+		if texture != 1 || width != 2 || height != 1 {
+			t.Errorf("%s() = %d, %d, %d, want 1, 2, 1", name, texture, width, height)
+		}
+		if got, want := b.CallsTo("TexImage2D")[0].Data, []byte{200, 0, 0, 128, 0, 0, 255, 255}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%s() pixels = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestLoadTextureErrors(t *testing.T) {
+	initBackend(t, "3.3.0", "")
+	missing := filepath.Join(t.TempDir(), "missing.png")
+	if _, _, _, err := gogl.LoadTexture(missing, gogl.TextureOptions{}); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("LoadTexture() error = %v, want %v", err, fs.ErrNotExist)
+	}
+	if _, _, _, err := gogl.LoadTextureFS(fstest.MapFS{}, "missing.png", gogl.TextureOptions{}); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("LoadTextureFS() error = %v, want %v", err, fs.ErrNotExist)
+	}
+	fsys := fstest.MapFS{"broken.png": {Data: []byte("not an image")}}
+	_, _, _, err := gogl.LoadTextureFS(fsys, "broken.png", gogl.TextureOptions{})
+	if err == nil || !strings.HasPrefix(err.Error(), "gogl: decoding texture broken.png: ") {
+		t.Errorf("LoadTextureFS() error = %v, want a decoding error", err)
+	}
+	_, _, _, err = gogl.LoadTextureReader(strings.NewReader(""), gogl.TextureOptions{})
+	if !errors.Is(err, image.ErrFormat) {
+		t.Errorf("LoadTextureReader() error = %v, want %v", err, image.ErrFormat)
+	}
+}
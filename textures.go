@@ -1,89 +1,125 @@
 package gogl
 
-import (
-	"unsafe"
-
-	"github.com/go-gl/gl/v2.1/gl"
-)
+import "fmt"
 
 // BindTexture binds a given Texture to a target (binding point).
 func BindTexture(target GLEnum, texture Texture) {
-	gl.BindTexture(uint32(target), uint32(texture))
+	backend.BindTexture(target, texture)
 }
 
 // CompressedTexImage2D and CompressedTexImage3D specify a two- or
-// three-dimensional texture image in a compressed format.
+// three-dimensional texture image in a compressed format. The size of the
+// image is the length of data, which holds the compressed blocks.
 //
 // Compressed image formats must be enabled by OpenGL extensions before using
 // these functions.
-func CompressedTexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border, imageSize int32, pixels []float32) {
-	gl.CompressedTexImage2D(uint32(target), level, uint32(internalformat), width, height, border, imageSize, unsafe.Pointer(&pixels[0]))
+func CompressedTexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border int32, data []byte) {
+	backend.CompressedTexImage2D(target, level, internalformat, width, height, border, int32(len(data)), slicePointer(data))
 }
 
 // CompressedTexSubImage2D specifies a two-dimensional sub-rectangle for a
-// texture image in a compressed format.
+// texture image in a compressed format. The size of the image is the length
+// of data. Nothing is updated if data is empty.
 //
 // Compressed image formats must be enabled by OpenGL extensions before using
 // this function.
-func CompressedTexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format GLEnum, imageSize int32, pixels []float32) {
-	gl.CompressedTexSubImage2D(uint32(target), level, xoffset, yoffset, width, height, uint32(format), imageSize, unsafe.Pointer(&pixels[0]))
+func CompressedTexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format GLEnum, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	backend.CompressedTexSubImage2D(target, level, xoffset, yoffset, width, height, format, int32(len(data)), slicePointer(data))
 }
 
 // CopyTexImage2D copies pixels from the current Framebuffer into a 2D texture
 // image.
 func CopyTexImage2D(target GLEnum, level int32, internalformat GLEnum, x, y, width, height, border int32) {
-	gl.CopyTexImage2D(uint32(target), level, uint32(internalformat), x, y, width, height, border)
+	backend.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
 }
 
 // CopyTexSubImage2D copies pixels from the current Framebuffer into an existing
 // 2D texture sub-image.
 func CopyTexSubImage2D(target GLEnum, level, xoffset, yoffset, x, y, width, height int32) {
-	gl.CopyTexSubImage2D(uint32(target), level, xoffset, yoffset, x, y, width, height)
+	backend.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, width, height)
 }
 
 // CreateTexture creates and initializes a Texture object.
 func CreateTexture() Texture {
-	var texture uint32
-	gl.GenTextures(1, &texture)
-	return Texture(texture)
+	return backend.CreateTexture()
 }
 
 // Delete deletes the Texture object. This method has no effect if the texture
 // has already been deleted.
 func (texture Texture) Delete() {
-	// TODO: Is it somehow possible to get &uint32(texture) without assigning it to textures?
-	textures := uint32(texture)
-	gl.DeleteTextures(1, &textures)
+	backend.DeleteTexture(texture)
 }
 
 // GenerateMipmap generates a set of mipmaps for a Texture object.
 func GenerateMipmap(target GLEnum) {
-	gl.GenerateMipmap(uint32(target))
+	backend.GenerateMipmap(target)
 }
 
 // TODO: GetTexParameter
 
 // IsTexture returns true if the Texture is valid and false otherwise.
 func (texture Texture) IsTexture() bool {
-	return gl.IsTexture(uint32(texture))
+	return backend.IsTexture(texture)
 }
 
 // TexImage2D specifies a two-dimensional texture image.
-func TexImage2D(target GLEnum, level int32, internalformat GLEnum, width, height, border int32, format, xtype GLEnum, pixels []float32) {
-	gl.TexImage2D(uint32(target), level, int32(internalformat), width, height, border, uint32(format), uint32(xtype), unsafe.Pointer(&pixels[0]))
+//
+// The pixels are given in the format and type, e.g., GLRGBA and GLUInt8, in
+// bottom-up order, and every row starts at a multiple of GetUnpackAlignment
+// bytes. The elements of pixels can be of any type matching the type of the
+// pixels, e.g., byte for GLUInt8 or float32 for GLFloat32. TexImage2D panics
+// if pixels is too small to hold the image, or if its element type contains
+// pointers, as BufferData does.
+//
+// If pixels is empty, the texture image is allocated, but its contents are
+// undefined, as with TexImage2DEmpty.
+func TexImage2D[E any](target GLEnum, level int32, internalformat GLEnum, width, height, border int32, format, xtype GLEnum, pixels []E) {
+	checkElementType("TexImage2D", pixels)
+	if len(pixels) > 0 {
+		checkImageData("TexImage2D", width, height, format, xtype, sliceSize(pixels))
+	}
+	backend.TexImage2D(target, level, internalformat, width, height, border, format, xtype, slicePointer(pixels))
+}
+
+// TexImage2DEmpty allocates a two-dimensional texture image without
+// specifying its contents, e.g., for a texture to render to.
+func TexImage2DEmpty(target GLEnum, level int32, internalformat GLEnum, width, height int32, format, xtype GLEnum) {
+	backend.TexImage2D(target, level, internalformat, width, height, 0, format, xtype, nil)
+}
+
+// TexSubImage2D specifies a sub-rectangle of the current texture. The pixels
+// are laid out as for TexImage2D. Nothing is updated if pixels is empty.
+func TexSubImage2D[E any](target GLEnum, level, xoffset, yoffset, width, height int32, format, xtype GLEnum, pixels []E) {
+	checkElementType("TexSubImage2D", pixels)
+	if len(pixels) == 0 {
+		return
+	}
+	checkImageData("TexSubImage2D", width, height, format, xtype, sliceSize(pixels))
+	backend.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, xtype, slicePointer(pixels))
 }
 
-// TexSubImage2D specifies a sub-rectangle of the current texture.
-func TexSubImage2D(target GLEnum, level, xoffset, yoffset, width, height int32, format, xtype GLEnum, pixels []float32) {
-	gl.TexSubImage2D(uint32(target), level, xoffset, yoffset, width, height, uint32(format), uint32(xtype), unsafe.Pointer(&pixels[0]))
+// checkImageData panics if size bytes are too few to hold the image data read
+// by OpenGL for an image of the given dimensions, format and type.
+func checkImageData(function string, width, height int32, format, xtype GLEnum, size int) {
+	if PixelSize(format, xtype) == 0 {
+		// Leave formats unknown to this package, e.g., of extensions, to
+		// OpenGL.
+		return
+	}
+	if required := imageDataSize(function, width, height, format, xtype, unpackAlignment); size < required {
+		panic(fmt.Sprintf("gogl: %s: %d bytes are too few for %dx%d pixels, which need %d", function, size, width, height, required))
+	}
 }
 
 // TexParameterf and TexParameteri set texture parameters.
 func TexParameterf(target, pname GLEnum, param float32) {
-	gl.TexParameterf(uint32(target), uint32(pname), param)
+	backend.TexParameterf(target, pname, param)
 }
 
 // TexParameteri and TexParameterf set texture parameters.
 func TexParameteri(target, pname GLEnum, param int32) {
-	gl.TexParameteri(uint32(target), uint32(pname), param)
+	backend.TexParameteri(target, pname, param)
 }
@@ -26,6 +26,10 @@ type Program uint32
 // types of shaders.
 type Shader uint32
 
+// VertexArray represents a vertex array object, which stores the vertex
+// attribute arrays and the index buffer used for drawing.
+type VertexArray uint32
+
 // UniformLocation represents the location of a uniform variable in a shader
 // program.
 type UniformLocation int32
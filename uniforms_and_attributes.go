@@ -1,11 +1,14 @@
 package gogl
 
-import "github.com/go-gl/gl/v2.1/gl"
+import "fmt"
 
 // DisableVertexAttribArray turns the generic vertex attribute array off at a
 // given index position.
 func DisableVertexAttribArray(index uint32) {
-	gl.DisableVertexAttribArray(index)
+	if state := recordingVertexArray(); state != nil {
+		state.attribute(index).enabled = false
+	}
+	backend.DisableVertexAttribArray(index)
 }
 
 // EnableVertexAttribArray turns on the generic attribute array at the specified
@@ -24,17 +27,88 @@ func DisableVertexAttribArray(index uint32) {
 // can be used to access the attribute, including VertexAttribPointer,
 // VertexAttrib, and GetVertexAttrib.
 func EnableVertexAttribArray(index uint32) {
-	gl.EnableVertexAttribArray(index)
+	if state := recordingVertexArray(); state != nil {
+		state.attribute(index).enabled = true
+	}
+	backend.EnableVertexAttribArray(index)
 }
 
-// TODO: GetActiveAttrib
+// ActiveAttribute describes an active attribute variable of a Program.
+type ActiveAttribute struct {
+	// Name is the name of the attribute as declared in the shader.
+	Name string
+	// Type is the type of the attribute, e.g., GLFloatVec3 or GLFloatMat4.
+	Type GLEnum
+	// Size is the number of elements of the attribute, which is 1 unless the
+	// attribute is an array.
+	Size int32
+	// Location is the location of the attribute, as returned by
+	// GetAttribLocation.
+	Location int32
+}
+
+// ActiveUniform describes an active uniform variable of a Program.
+type ActiveUniform struct {
+	// Name is the name of the uniform as declared in the shader. Arrays are
+	// named after their first element, e.g., "lights[0]".
+	Name string
+	// Type is the type of the uniform, one of the "Uniform types" constants,
+	// GLFloat32 or GLInt32.
+	Type GLEnum
+	// Size is the number of elements of the uniform, which is 1 unless the
+	// uniform is an array.
+	Size int32
+	// Location is the location of the uniform (or of its first element), as
+	// returned by GetUniformLocation.
+	Location UniformLocation
+}
+
+// GetActiveAttrib returns information about the active attribute variable at
+// index, which must be less than the value returned by GetActiveAttributes.
+func (program Program) GetActiveAttrib(index uint32) ActiveAttribute {
+	name, size, xtype := backend.GetActiveAttrib(program, index)
+	return ActiveAttribute{
+		Name:     name,
+		Type:     xtype,
+		Size:     size,
+		Location: program.GetAttribLocation(name),
+	}
+}
+
+// GetActiveUniform returns information about the active uniform variable at
+// index, which must be less than the value returned by GetActiveUniforms.
+func (program Program) GetActiveUniform(index uint32) ActiveUniform {
+	name, size, xtype := backend.GetActiveUniform(program, index)
+	return ActiveUniform{
+		Name:     name,
+		Type:     xtype,
+		Size:     size,
+		Location: program.GetUniformLocation(name),
+	}
+}
+
+// Attributes returns all active attribute variables of the linked Program.
+func (program Program) Attributes() []ActiveAttribute {
+	attributes := make([]ActiveAttribute, program.GetActiveAttributes())
+	for i := range attributes {
+		attributes[i] = program.GetActiveAttrib(uint32(i))
+	}
+	return attributes
+}
 
-// TODO: GetActiveUniform
+// Uniforms returns all active uniform variables of the linked Program.
+func (program Program) Uniforms() []ActiveUniform {
+	uniforms := make([]ActiveUniform, program.GetActiveUniforms())
+	for i := range uniforms {
+		uniforms[i] = program.GetActiveUniform(uint32(i))
+	}
+	return uniforms
+}
 
 // GetAttribLocation returns the location of an attribute variable in the
 // Program.
 func (program Program) GetAttribLocation(name string) int32 {
-	return gl.GetAttribLocation(uint32(program), gl.Str(name+"\x00"))
+	return backend.GetAttribLocation(program, name)
 }
 
 // TODO: GetUniform
@@ -50,7 +124,7 @@ func (program Program) GetAttribLocation(name string) int32 {
 //
 // The uniform itself is declared in the shader program using GLSL.
 func (program Program) GetUniformLocation(name string) UniformLocation {
-	return UniformLocation(gl.GetUniformLocation(uint32(program), gl.Str(name+"\x00")))
+	return backend.GetUniformLocation(program, name)
 }
 
 // TODO: GetVertexAttrib
@@ -59,152 +133,255 @@ func (program Program) GetUniformLocation(name string) UniformLocation {
 
 // Uniform1Float specifies values of uniform variables.
 func Uniform1Float(location UniformLocation, v0 float32) {
-	gl.Uniform1f(int32(location), v0)
+	backend.Uniform1f(location, v0)
 }
 
-// Uniform1FloatArray specifies values of uniform variables.
+// Uniform1FloatArray specifies the values of a float uniform variable or array.
+// Every element of value is uploaded to an element of the array, starting at
+// location. Nothing is uploaded if value is empty.
 func Uniform1FloatArray(location UniformLocation, value []float32) {
-	gl.Uniform1fv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform1FloatArray", len(value), 1); count > 0 {
+		backend.Uniform1fv(location, count, value)
+	}
 }
 
 // Uniform1Int specifies values of uniform variables.
 func Uniform1Int(location UniformLocation, v0 int32) {
-	gl.Uniform1i(int32(location), v0)
+	backend.Uniform1i(location, v0)
 }
 
-// Uniform1IntArray specifies values of uniform variables.
+// Uniform1IntArray specifies the values of an int uniform variable or array.
+// Every element of value is uploaded to an element of the array, starting at
+// location. Nothing is uploaded if value is empty.
 func Uniform1IntArray(location UniformLocation, value []int32) {
-	gl.Uniform1iv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform1IntArray", len(value), 1); count > 0 {
+		backend.Uniform1iv(location, count, value)
+	}
 }
 
 // Uniform2Float specifies values of uniform variables.
 func Uniform2Float(location UniformLocation, v0, v1 float32) {
-	gl.Uniform2f(int32(location), v0, v1)
+	backend.Uniform2f(location, v0, v1)
 }
 
-// Uniform2FloatArray specifies values of uniform variables.
+// Uniform2FloatArray specifies the values of a vec2 uniform variable or array.
+// Every 2 elements of value are uploaded to an element of the array, starting
+// at location, so the length of value must be a multiple of 2. Nothing is
+// uploaded if value is empty.
 func Uniform2FloatArray(location UniformLocation, value []float32) {
-	gl.Uniform2fv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform2FloatArray", len(value), 2); count > 0 {
+		backend.Uniform2fv(location, count, value)
+	}
 }
 
 // Uniform2Int specifies values of uniform variables.
 func Uniform2Int(location UniformLocation, v0, v1 int32) {
-	gl.Uniform2i(int32(location), v0, v1)
+	backend.Uniform2i(location, v0, v1)
 }
 
-// Uniform2IntArray specifies values of uniform variables.
+// Uniform2IntArray specifies the values of an ivec2 uniform variable or array.
+// Every 2 elements of value are uploaded to an element of the array, starting
+// at location, so the length of value must be a multiple of 2. Nothing is
+// uploaded if value is empty.
 func Uniform2IntArray(location UniformLocation, value []int32) {
-	gl.Uniform2iv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform2IntArray", len(value), 2); count > 0 {
+		backend.Uniform2iv(location, count, value)
+	}
 }
 
 // Uniform3Float specifies values of uniform variables.
 func Uniform3Float(location UniformLocation, v0, v1, v2 float32) {
-	gl.Uniform3f(int32(location), v0, v1, v2)
+	backend.Uniform3f(location, v0, v1, v2)
 }
 
-// Uniform3FloatArray specifies values of uniform variables.
+// Uniform3FloatArray specifies the values of a vec3 uniform variable or array.
+// Every 3 elements of value are uploaded to an element of the array, starting
+// at location, so the length of value must be a multiple of 3. Nothing is
+// uploaded if value is empty.
 func Uniform3FloatArray(location UniformLocation, value []float32) {
-	gl.Uniform3fv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform3FloatArray", len(value), 3); count > 0 {
+		backend.Uniform3fv(location, count, value)
+	}
 }
 
 // Uniform3Int specifies values of uniform variables.
 func Uniform3Int(location UniformLocation, v0, v1, v2 int32) {
-	gl.Uniform3i(int32(location), v0, v1, v2)
+	backend.Uniform3i(location, v0, v1, v2)
 }
 
-// Uniform3IntArray specifies values of uniform variables.
+// Uniform3IntArray specifies the values of an ivec3 uniform variable or array.
+// Every 3 elements of value are uploaded to an element of the array, starting
+// at location, so the length of value must be a multiple of 3. Nothing is
+// uploaded if value is empty.
 func Uniform3IntArray(location UniformLocation, value []int32) {
-	gl.Uniform3iv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform3IntArray", len(value), 3); count > 0 {
+		backend.Uniform3iv(location, count, value)
+	}
 }
 
 // Uniform4Float specifies values of uniform variables.
 func Uniform4Float(location UniformLocation, v0, v1, v2, v3 float32) {
-	gl.Uniform4f(int32(location), v0, v1, v2, v3)
+	backend.Uniform4f(location, v0, v1, v2, v3)
 }
 
-// Uniform4FloatArray specifies values of uniform variables.
+// Uniform4FloatArray specifies the values of a vec4 uniform variable or array.
+// Every 4 elements of value are uploaded to an element of the array, starting
+// at location, so the length of value must be a multiple of 4. Nothing is
+// uploaded if value is empty.
 func Uniform4FloatArray(location UniformLocation, value []float32) {
-	gl.Uniform4fv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform4FloatArray", len(value), 4); count > 0 {
+		backend.Uniform4fv(location, count, value)
+	}
 }
 
 // Uniform4Int specifies values of uniform variables.
 func Uniform4Int(location UniformLocation, v0, v1, v2, v3 int32) {
-	gl.Uniform4i(int32(location), v0, v1, v2, v3)
+	backend.Uniform4i(location, v0, v1, v2, v3)
 }
 
-// Uniform4IntArray specifies values of uniform variables.
+// Uniform4IntArray specifies the values of an ivec4 uniform variable or array.
+// Every 4 elements of value are uploaded to an element of the array, starting
+// at location, so the length of value must be a multiple of 4. Nothing is
+// uploaded if value is empty.
 func Uniform4IntArray(location UniformLocation, value []int32) {
-	gl.Uniform4iv(int32(location), 1, &value[0])
+	if count := uniformCount("Uniform4IntArray", len(value), 4); count > 0 {
+		backend.Uniform4iv(location, count, value)
+	}
 }
 
 // UniformMatrix2fv specifies matrix values for uniform variables.
 //
 // The three versions of this method (UniformMatrix2fv, UniformMatrix3fv, and
 // UniformMatrix4fv) take as the input value 2-component, 3-component, and
-// 4-component square matrices, respectively. They are expected to have 4, 9 or
-// 16 floats.
+// 4-component square matrices, respectively. Every 4, 9 or 16 floats of value
+// are uploaded to an element of a matrix array, starting at location, so the
+// length of value must be a multiple of that size. Nothing is uploaded if value
+// is empty.
 func UniformMatrix2fv(location UniformLocation, transpose bool, value []float32) {
-	gl.UniformMatrix2fv(int32(location), 1, transpose, &value[0])
+	if count := uniformCount("UniformMatrix2fv", len(value), 4); count > 0 {
+		backend.UniformMatrix2fv(location, count, transpose, value)
+	}
 }
 
 // UniformMatrix3fv specifies matrix values for uniform variables.
 //
 // The three versions of this method (UniformMatrix2fv, UniformMatrix3fv, and
 // UniformMatrix4fv) take as the input value 2-component, 3-component, and
-// 4-component square matrices, respectively. They are expected to have 4, 9 or
-// 16 floats.
+// 4-component square matrices, respectively. Every 4, 9 or 16 floats of value
+// are uploaded to an element of a matrix array, starting at location, so the
+// length of value must be a multiple of that size. Nothing is uploaded if value
+// is empty.
 func UniformMatrix3fv(location UniformLocation, transpose bool, value []float32) {
-	gl.UniformMatrix3fv(int32(location), 1, transpose, &value[0])
+	if count := uniformCount("UniformMatrix3fv", len(value), 9); count > 0 {
+		backend.UniformMatrix3fv(location, count, transpose, value)
+	}
 }
 
 // UniformMatrix4fv specifies matrix values for uniform variables.
 //
 // The three versions of this method (UniformMatrix2fv, UniformMatrix3fv, and
 // UniformMatrix4fv) take as the input value 2-component, 3-component, and
-// 4-component square matrices, respectively. They are expected to have 4, 9 or
-// 16 floats.
+// 4-component square matrices, respectively. Every 4, 9 or 16 floats of value
+// are uploaded to an element of a matrix array, starting at location, so the
+// length of value must be a multiple of that size. Nothing is uploaded if value
+// is empty.
 func UniformMatrix4fv(location UniformLocation, transpose bool, value []float32) {
-	gl.UniformMatrix4fv(int32(location), 1, transpose, &value[0])
+	if count := uniformCount("UniformMatrix4fv", len(value), 16); count > 0 {
+		backend.UniformMatrix4fv(location, count, transpose, value)
+	}
+}
+
+// uniformCount returns the number of elements of size components in a slice of
+// the given length. It panics if the length is not a multiple of size, which
+// would make the driver ignore the trailing values or fail the call.
+func uniformCount(function string, length, size int) int32 {
+	if length%size != 0 {
+		panic(fmt.Sprintf("gogl: %s: length %d is not a multiple of %d", function, length, size))
+	}
+	return int32(length / size)
 }
 
 // VertexAttrib1f specifies constant values for generic vertex attributes.
 func VertexAttrib1f(index uint32, v0 float32) {
-	gl.VertexAttrib1f(index, v0)
+	backend.VertexAttrib1f(index, v0)
 }
 
 // VertexAttrib2f specifies constant values for generic vertex attributes.
 func VertexAttrib2f(index uint32, v0, v1 float32) {
-	gl.VertexAttrib2f(index, v0, v1)
+	backend.VertexAttrib2f(index, v0, v1)
 }
 
 // VertexAttrib3f specifies constant values for generic vertex attributes.
 func VertexAttrib3f(index uint32, v0, v1, v2 float32) {
-	gl.VertexAttrib3f(index, v0, v1, v2)
+	backend.VertexAttrib3f(index, v0, v1, v2)
 }
 
 // VertexAttrib4f specifies constant values for generic vertex attributes.
 func VertexAttrib4f(index uint32, v0, v1, v2, v3 float32) {
-	gl.VertexAttrib4f(index, v0, v1, v2, v3)
+	backend.VertexAttrib4f(index, v0, v1, v2, v3)
 }
 
 // VertexAttrib1fv specifies constant values for generic vertex attributes.
 func VertexAttrib1fv(index uint32, value []float32) {
-	gl.VertexAttrib1fv(index, &value[0])
+	backend.VertexAttrib1fv(index, value)
 }
 
 // VertexAttrib2fv specifies constant values for generic vertex attributes.
 func VertexAttrib2fv(index uint32, value []float32) {
-	gl.VertexAttrib2fv(index, &value[0])
+	backend.VertexAttrib2fv(index, value)
 }
 
 // VertexAttrib3fv specifies constant values for generic vertex attributes.
 func VertexAttrib3fv(index uint32, value []float32) {
-	gl.VertexAttrib3fv(index, &value[0])
+	backend.VertexAttrib3fv(index, value)
 }
 
 // VertexAttrib4fv specifies constant values for generic vertex attributes.
 func VertexAttrib4fv(index uint32, value []float32) {
-	gl.VertexAttrib4fv(index, &value[0])
+	backend.VertexAttrib4fv(index, value)
 }
 
-// TODO: VertexAttribPointer
+// VertexAttribDivisor sets the rate at which the generic vertex attribute array
+// at index advances during instanced drawing. With a divisor of 0, the default,
+// it advances once per vertex. Otherwise, it advances once per divisor
+// instances.
+//
+// It returns ErrInstancingUnsupported if the context does not support
+// instanced drawing. Support is checked once after Init.
+func VertexAttribDivisor(index, divisor uint32) error {
+	if !InstancingSupported() {
+		return ErrInstancingUnsupported
+	}
+	if state := recordingVertexArray(); state != nil {
+		state.attribute(index).divisor = divisor
+	}
+	backend.VertexAttribDivisor(index, divisor)
+	return nil
+}
+
+// VertexAttribPointer specifies the layout of the generic vertex attribute
+// array at index, sourced from the Buffer currently bound to GLArrayBuffer.
+//
+// Every vertex has size components of type xtype, e.g., GLFloat32 or GLUInt8,
+// starting at offset bytes into the buffer. The stride is the distance in bytes
+// between the starts of consecutive vertices, or 0 if they are tightly packed.
+// If normalized is true, integer components are mapped to [0, 1] (unsigned) or
+// [-1, 1] (signed).
+//
+// A VertexLayout computes strides and offsets of interleaved attributes and
+// applies them to a Program.
+func VertexAttribPointer(index uint32, size int32, xtype GLEnum, normalized bool, stride int32, offset int) {
+	if state := recordingVertexArray(); state != nil {
+		data := make([]int32, 1)
+		backend.GetIntegerv(GLArrayBufferBinding, data)
+		attribute := state.attribute(index)
+		attribute.pointer = true
+		attribute.buffer = Buffer(data[0])
+		attribute.size = size
+		attribute.xtype = xtype
+		attribute.normalized = normalized
+		attribute.stride = stride
+		attribute.offset = offset
+	}
+	backend.VertexAttribPointer(index, size, xtype, normalized, stride, offset)
+}
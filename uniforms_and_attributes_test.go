@@ -0,0 +1,133 @@
+package gogl_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestProgramAttributes(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	program := gogl.CreateProgram()
+	want := []gogl.ActiveAttribute{
+		{Name: "a_position", Type: gogl.GLFloatVec3, Size: 1, Location: 0},
+		{Name: "a_color", Type: gogl.GLFloatVec4, Size: 1, Location: 2},
+		{Name: "a_model", Type: gogl.GLFloatMat4, Size: 1, Location: 4},
+	}
+	b.SetActiveAttributes(program, want...)
+
+	if got := program.GetActiveAttributes(); got != int32(len(want)) {
+		t.Errorf("GetActiveAttributes() = %d, want %d", got, len(want))
+	}
+	if got := program.GetActiveAttrib(1); got != want[1] {
+		t.Errorf("GetActiveAttrib(1) = %+v, want %+v", got, want[1])
+	}
+	if got := program.Attributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Attributes() = %+v, want %+v", got, want)
+	}
+	if got := program.GetAttribLocation("a_normal"); got != -1 {
+		t.Errorf("GetAttribLocation(%q) = %d, want -1", "a_normal", got)
+	}
+}
+
+func TestProgramUniforms(t *testing.T) {
+	b := initBackend(t, "3.3.0", "")
+	program := gogl.CreateProgram()
+	want := []gogl.ActiveUniform{
+		{Name: "u_mvp", Type: gogl.GLFloatMat4, Size: 1, Location: 0},
+		{Name: "u_texture", Type: gogl.GLSampler2D, Size: 1, Location: 1},
+		{Name: "u_lights[0]", Type: gogl.GLFloatVec3, Size: 4, Location: 5},
+	}
+	b.SetActiveUniforms(program, want...)
+
+	if got := program.GetActiveUniforms(); got != int32(len(want)) {
+		t.Errorf("GetActiveUniforms() = %d, want %d", got, len(want))
+	}
+	if got := program.GetActiveUniform(2); got != want[2] {
+		t.Errorf("GetActiveUniform(2) = %+v, want %+v", got, want[2])
+	}
+	if got := program.Uniforms(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Uniforms() = %+v, want %+v", got, want)
+	}
+	for name, location := range map[string]gogl.UniformLocation{
+		"u_lights":    5,
+		"u_lights[2]": 7,
+		"u_lights[4]": -1,
+		"u_missing":   -1,
+	} {
+		if got := program.GetUniformLocation(name); got != location {
+			t.Errorf("GetUniformLocation(%q) = %d, want %d", name, got, location)
+		}
+	}
+}
+
+func TestProgramWithoutActiveVariables(t *testing.T) {
+	initBackend(t, "3.3.0", "")
+	program := gogl.CreateProgram()
+	if got := program.Attributes(); len(got) != 0 {
+		t.Errorf("Attributes() = %+v, want none", got)
+	}
+	if got := program.Uniforms(); len(got) != 0 {
+		t.Errorf("Uniforms() = %+v, want none", got)
+	}
+}
+
+func TestUniformArrays(t *testing.T) {
+	floats := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36}
+	ints := []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	tests := []struct {
+		name  string
+		set   func(length int)
+		size  int
+		count int
+	}{
+		{"Uniform1FloatArray", func(n int) { gogl.Uniform1FloatArray(2, floats[:n]) }, 1, 12},
+		{"Uniform2FloatArray", func(n int) { gogl.Uniform2FloatArray(2, floats[:n]) }, 2, 6},
+		{"Uniform3FloatArray", func(n int) { gogl.Uniform3FloatArray(2, floats[:n]) }, 3, 4},
+		{"Uniform4FloatArray", func(n int) { gogl.Uniform4FloatArray(2, floats[:n]) }, 4, 3},
+		{"Uniform1IntArray", func(n int) { gogl.Uniform1IntArray(2, ints[:n]) }, 1, 12},
+		{"Uniform2IntArray", func(n int) { gogl.Uniform2IntArray(2, ints[:n]) }, 2, 6},
+		{"Uniform3IntArray", func(n int) { gogl.Uniform3IntArray(2, ints[:n]) }, 3, 4},
+		{"Uniform4IntArray", func(n int) { gogl.Uniform4IntArray(2, ints[:n]) }, 4, 3},
+		{"UniformMatrix2fv", func(n int) { gogl.UniformMatrix2fv(2, false, floats[:n]) }, 4, 3},
+		{"UniformMatrix3fv", func(n int) { gogl.UniformMatrix3fv(2, false, floats[:n]) }, 9, 4},
+		{"UniformMatrix4fv", func(n int) { gogl.UniformMatrix4fv(2, true, floats[:n]) }, 16, 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := initBackend(t, "3.3.0", "")
+			length := test.count * test.size
+
+			test.set(length)
+			calls := b.Calls
+			if len(calls) != 1 {
+				t.Fatalf("calls = %v, want 1 call", calls)
+			}
+			if got := calls[0].Args[1]; got != int32(test.count) {
+				t.Errorf("count = %v, want %d", got, test.count)
+			}
+			if got := reflect.ValueOf(calls[0].Args[len(calls[0].Args)-1]).Len(); got != length {
+				t.Errorf("uploaded %d values, want %d", got, length)
+			}
+
+			b.ClearCalls()
+			test.set(0)
+			if len(b.Calls) != 0 {
+				t.Errorf("calls for an empty slice = %v, want none", b.Calls)
+			}
+
+			if test.size > 1 {
+				defer func() {
+					want := fmt.Sprintf("gogl: %s: length %d is not a multiple of %d", test.name, length-1, test.size)
+					if r := recover(); r != want {
+						t.Errorf("panic = %v, want %q", r, want)
+					}
+				}()
+				test.set(length - 1)
+				t.Errorf("%s did not panic for %d values", test.name, length-1)
+			}
+		})
+	}
+}
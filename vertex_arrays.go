@@ -0,0 +1,201 @@
+package gogl
+
+import "sort"
+
+// CreateVertexArray creates a VertexArray.
+//
+// If the context supports vertex array objects, i.e., it is an OpenGL 3.0 or
+// newer context or supports GL_ARB_vertex_array_object, a VertexArray is a
+// vertex array object. Otherwise, it is emulated: while it is bound, the calls
+// to EnableVertexAttribArray, DisableVertexAttribArray, VertexAttribPointer,
+// VertexAttribDivisor and BindBuffer(GLElementArrayBuffer, ...) are recorded,
+// and replayed when it is bound again. The calls made while no VertexArray is
+// bound are recorded for the VertexArray 0 in the same way, so that binding a
+// VertexArray disables the vertex attribute arrays enabled outside of it, and
+// binding the VertexArray 0 restores them. Only calls made through the
+// functions of this package are recorded.
+func CreateVertexArray() VertexArray {
+	if !emulateVertexArrays() {
+		return backend.CreateVertexArray()
+	}
+	if emulatedVertexArrays == nil {
+		emulatedVertexArrays = map[VertexArray]*emulatedVertexArray{}
+	}
+	lastEmulatedVertexArray++
+	emulatedVertexArrays[lastEmulatedVertexArray] = newEmulatedVertexArray()
+	return lastEmulatedVertexArray
+}
+
+// Bind binds the VertexArray. Binding the VertexArray 0 unbinds the bound one,
+// and restores the default vertex array state.
+func (array VertexArray) Bind() {
+	if !emulateVertexArrays() {
+		backend.BindVertexArray(array)
+		return
+	}
+	bindEmulatedVertexArray(emulatedVertexArrays[array])
+}
+
+// Delete deletes the VertexArray. If it is bound, the VertexArray 0 is bound
+// instead. This method has no effect if the vertex array has already been
+// deleted.
+func (array VertexArray) Delete() {
+	if !emulateVertexArrays() {
+		backend.DeleteVertexArray(array)
+		return
+	}
+	state, ok := emulatedVertexArrays[array]
+	if !ok {
+		return
+	}
+	if state == boundEmulatedVertexArray {
+		bindEmulatedVertexArray(nil)
+	}
+	delete(emulatedVertexArrays, array)
+}
+
+// IsVertexArray returns true if the VertexArray is valid and false otherwise.
+func (array VertexArray) IsVertexArray() bool {
+	if !emulateVertexArrays() {
+		return backend.IsVertexArray(array)
+	}
+	_, ok := emulatedVertexArrays[array]
+	return ok
+}
+
+// emulateVertexArrays reports whether vertex arrays are emulated because the
+// context does not support vertex array objects.
+func emulateVertexArrays() bool {
+	return !currentCapabilities().vertexArrays
+}
+
+// emulatedVertexArray is the state recorded for an emulated VertexArray.
+type emulatedVertexArray struct {
+	attributes    map[uint32]*emulatedAttribute
+	elementBuffer Buffer
+}
+
+// newEmulatedVertexArray returns the state of a new emulated VertexArray.
+func newEmulatedVertexArray() *emulatedVertexArray {
+	return &emulatedVertexArray{attributes: map[uint32]*emulatedAttribute{}}
+}
+
+// emulatedAttribute is the state of a vertex attribute array recorded for an
+// emulated VertexArray.
+type emulatedAttribute struct {
+	enabled bool
+	// pointer is true if VertexAttribPointer has been called, and the fields
+	// below hold its arguments.
+	pointer    bool
+	buffer     Buffer
+	size       int32
+	xtype      GLEnum
+	normalized bool
+	stride     int32
+	offset     int
+	// divisor is set by VertexAttribDivisor.
+	divisor uint32
+}
+
+var (
+	// emulatedVertexArrays are the emulated VertexArrays by name. They are
+	// dropped by InitWithBackend.
+	emulatedVertexArrays    map[VertexArray]*emulatedVertexArray
+	lastEmulatedVertexArray VertexArray
+	// boundEmulatedVertexArray is the bound emulated VertexArray, which
+	// records the vertex attribute state, or nil.
+	boundEmulatedVertexArray *emulatedVertexArray
+	// defaultEmulatedVertexArray records the vertex attribute state while no
+	// emulated VertexArray is bound. It is created on first use, and dropped
+	// by InitWithBackend.
+	defaultEmulatedVertexArray *emulatedVertexArray
+)
+
+// recordingVertexArray returns the state the vertex attribute calls are
+// recorded in: the bound emulated VertexArray, or the state of the
+// VertexArray 0 if none is bound. It returns nil if vertex arrays are not
+// emulated.
+func recordingVertexArray() *emulatedVertexArray {
+	if boundEmulatedVertexArray != nil {
+		return boundEmulatedVertexArray
+	}
+	if !emulateVertexArrays() {
+		return nil
+	}
+	if defaultEmulatedVertexArray == nil {
+		defaultEmulatedVertexArray = newEmulatedVertexArray()
+	}
+	return defaultEmulatedVertexArray
+}
+
+// attribute returns the state of the vertex attribute array at index.
+func (state *emulatedVertexArray) attribute(index uint32) *emulatedAttribute {
+	attribute, ok := state.attributes[index]
+	if !ok {
+		attribute = &emulatedAttribute{}
+		state.attributes[index] = attribute
+	}
+	return attribute
+}
+
+// attributeIndices returns the indices of the vertex attribute arrays recorded
+// in any of the states in ascending order, so that they are replayed in a
+// stable order.
+func attributeIndices(states ...*emulatedVertexArray) []uint32 {
+	seen := map[uint32]bool{}
+	var indices []uint32
+	for _, state := range states {
+		for index := range state.attributes {
+			if !seen[index] {
+				seen[index] = true
+				indices = append(indices, index)
+			}
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices
+}
+
+// bindEmulatedVertexArray replays the state of the emulated VertexArray, or of
+// the VertexArray 0 if state is nil. The vertex attribute arrays recorded by
+// the previously bound state, but not by the new one, are reset to their
+// defaults. The GLArrayBuffer binding is restored afterwards, as it is not
+// part of the vertex array state.
+func bindEmulatedVertexArray(state *emulatedVertexArray) {
+	previous := recordingVertexArray()
+	boundEmulatedVertexArray = state
+	next := recordingVertexArray()
+
+	data := make([]int32, 1)
+	backend.GetIntegerv(GLArrayBufferBinding, data)
+	arrayBuffer := Buffer(data[0])
+	current := arrayBuffer
+	for _, index := range attributeIndices(previous, next) {
+		var attribute, old emulatedAttribute
+		if recorded := next.attributes[index]; recorded != nil {
+			attribute = *recorded
+		}
+		if recorded := previous.attributes[index]; recorded != nil {
+			old = *recorded
+		}
+		if attribute.pointer {
+			if attribute.buffer != current {
+				backend.BindBuffer(GLArrayBuffer, attribute.buffer)
+				current = attribute.buffer
+			}
+			backend.VertexAttribPointer(index, attribute.size, attribute.xtype, attribute.normalized, attribute.stride, attribute.offset)
+		}
+		if attribute.divisor != old.divisor {
+			backend.VertexAttribDivisor(index, attribute.divisor)
+		}
+		if attribute.enabled {
+			backend.EnableVertexAttribArray(index)
+		} else if old.enabled {
+			backend.DisableVertexAttribArray(index)
+		}
+	}
+	if current != arrayBuffer {
+		backend.BindBuffer(GLArrayBuffer, arrayBuffer)
+	}
+	backend.BindBuffer(GLElementArrayBuffer, next.elementBuffer)
+}
@@ -0,0 +1,70 @@
+package gogl_test
+
+import (
+	"testing"
+
+	"github.com/pegasus-toolset/gogl"
+)
+
+func TestEmulatedVertexArray(t *testing.T) {
+	b := initBackend(t, "2.1 Mesa 23.0.4", "")
+
+	// The default vertex array state enables the array 0.
+	vertices, indices := gogl.CreateBuffer(), gogl.CreateBuffer()
+	gogl.BindBuffer(gogl.GLElementArrayBuffer, indices)
+	gogl.BindBuffer(gogl.GLArrayBuffer, vertices)
+	gogl.EnableVertexAttribArray(0)
+	gogl.VertexAttribPointer(0, 3, gogl.GLFloat32, false, 12, 0)
+
+	array := gogl.CreateVertexArray()
+	if b.CallsTo("CreateVertexArray") != nil {
+		t.Fatal("CreateVertexArray() called the Backend, want an emulated vertex array")
+	}
+	array.Bind()
+	if b.VertexAttribArrayEnabled(0) {
+		t.Error("binding a vertex array left the array 0 of the default state enabled")
+	}
+	if got := b.Binding(gogl.GLElementArrayBuffer); got != 0 {
+		t.Errorf("GL_ELEMENT_ARRAY_BUFFER after binding a vertex array = %d, want 0", got)
+	}
+	gogl.EnableVertexAttribArray(1)
+	gogl.VertexAttribPointer(1, 2, gogl.GLFloat32, true, 8, 4)
+	gogl.BindBuffer(gogl.GLElementArrayBuffer, vertices)
+
+	gogl.VertexArray(0).Bind()
+	if !b.VertexAttribArrayEnabled(0) || b.VertexAttribArrayEnabled(1) {
+		t.Errorf("arrays 0 and 1 enabled after binding the vertex array 0 = %t, %t, want true, false", b.VertexAttribArrayEnabled(0), b.VertexAttribArrayEnabled(1))
+	}
+	if got := b.Binding(gogl.GLElementArrayBuffer); got != uint32(indices) {
+		t.Errorf("GL_ELEMENT_ARRAY_BUFFER after binding the vertex array 0 = %d, want %d", got, indices)
+	}
+	if pointer, _ := b.AttribPointer(0); pointer.Size != 3 || pointer.Stride != 12 {
+		t.Errorf("AttribPointer(0) after binding the vertex array 0 = %+v, want the default state", pointer)
+	}
+
+	b.ClearCalls()
+	array.Bind()
+	if b.VertexAttribArrayEnabled(0) || !b.VertexAttribArrayEnabled(1) {
+		t.Errorf("arrays 0 and 1 enabled after binding the vertex array again = %t, %t, want false, true", b.VertexAttribArrayEnabled(0), b.VertexAttribArrayEnabled(1))
+	}
+	if pointer, _ := b.AttribPointer(1); pointer.Size != 2 || !pointer.Normalized || pointer.Stride != 8 || pointer.Offset != 4 || pointer.Buffer != vertices {
+		t.Errorf("AttribPointer(1) = %+v, want the recorded pointer", pointer)
+	}
+	if got := b.Binding(gogl.GLElementArrayBuffer); got != uint32(vertices) {
+		t.Errorf("GL_ELEMENT_ARRAY_BUFFER after binding the vertex array again = %d, want %d", got, vertices)
+	}
+
+	array.Delete()
+	if array.IsVertexArray() || !b.VertexAttribArrayEnabled(0) {
+		t.Error("deleting the bound vertex array did not restore the default state")
+	}
+}
+
+func TestNativeVertexArray(t *testing.T) {
+	b := initBackend(t, "2.1 Mesa 23.0.4", "GL_ARB_vertex_array_object")
+	array := gogl.CreateVertexArray()
+	array.Bind()
+	if len(b.CallsTo("CreateVertexArray")) != 1 || len(b.CallsTo("BindVertexArray")) != 1 {
+		t.Errorf("calls = %v, want CreateVertexArray and BindVertexArray to be forwarded to the Backend", b.Calls)
+	}
+}
@@ -0,0 +1,187 @@
+package gogl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// VertexAttribute describes an attribute of the vertices of a VertexLayout.
+type VertexAttribute struct {
+	// Name is the name of the attribute variable in the vertex shader.
+	Name string
+	// Components is the number of components of the attribute, from 1 to 4.
+	Components int32
+	// Type is the type of the components, one of the "Data types" constants,
+	// e.g., GLFloat32 or GLUInt8.
+	Type GLEnum
+	// Normalized specifies whether integer components are mapped to [0, 1]
+	// (unsigned) or [-1, 1] (signed).
+	Normalized bool
+	// Offset is the offset of the attribute in bytes from the start of a
+	// vertex. NewVertexLayout computes it.
+	Offset int
+}
+
+// Size returns the size of the attribute in bytes.
+func (attribute VertexAttribute) Size() int {
+	return int(attribute.Components) * typeSize(attribute.Type)
+}
+
+// VertexLayout describes the interleaved attributes of the vertices stored in
+// a Buffer.
+type VertexLayout struct {
+	// Attributes are the attributes of a vertex.
+	Attributes []VertexAttribute
+	// Stride is the size of a vertex in bytes, including padding.
+	Stride int32
+}
+
+// NewVertexLayout returns a VertexLayout of the attributes, which are packed
+// tightly in the given order. The offsets of the attributes and the stride are
+// computed from the number and type of their components.
+func NewVertexLayout(attributes ...VertexAttribute) VertexLayout {
+	layout := VertexLayout{Attributes: make([]VertexAttribute, len(attributes))}
+	offset := 0
+	for i, attribute := range attributes {
+		if attribute.Components < 1 || attribute.Components > 4 {
+			panic(fmt.Sprintf("gogl: NewVertexLayout: attribute %q has %d components", attribute.Name, attribute.Components))
+		}
+		attribute.Offset = offset
+		layout.Attributes[i] = attribute
+		offset += attribute.Size()
+	}
+	layout.Stride = int32(offset)
+	return layout
+}
+
+// Apply enables the vertex attribute arrays of the attributes of the layout
+// and specifies their layout, sourced from the Buffer currently bound to
+// GLArrayBuffer. The locations of the attributes are looked up by name in the
+// Program. Attributes that are not active in the Program, e.g., because they
+// are not used by its shaders, are skipped.
+func (layout VertexLayout) Apply(program Program) {
+	layout.ApplyAt(program, 0)
+}
+
+// ApplyAt is like Apply, but the vertices start at offset bytes into the
+// Buffer, e.g., if the Buffer holds the vertices of several meshes.
+func (layout VertexLayout) ApplyAt(program Program, offset int) {
+	for _, attribute := range layout.Attributes {
+		location := program.GetAttribLocation(attribute.Name)
+		if location < 0 {
+			continue
+		}
+		EnableVertexAttribArray(uint32(location))
+		VertexAttribPointer(uint32(location), attribute.Components, attribute.Type, attribute.Normalized, layout.Stride, offset+attribute.Offset)
+	}
+}
+
+// Disable disables the vertex attribute arrays enabled by Apply.
+func (layout VertexLayout) Disable(program Program) {
+	for _, attribute := range layout.Attributes {
+		if location := program.GetAttribLocation(attribute.Name); location >= 0 {
+			DisableVertexAttribArray(uint32(location))
+		}
+	}
+}
+
+// typeSize returns the size in bytes of a value of one of the "Data types"
+// constants.
+func typeSize(xtype GLEnum) int {
+	switch xtype {
+	case GLInt8, GLUInt8:
+		return 1
+	case GLInt16, GLUInt16:
+		return 2
+	case GLInt32, GLUInt32, GLFloat32:
+		return 4
+	}
+	panic(fmt.Sprintf("gogl: unsupported data type %v", xtype))
+}
+
+// VertexLayoutOf returns the VertexLayout of vertices stored as values of the
+// struct type V, so that a []V uploaded with BufferData can be drawn without
+// computing strides and offsets by hand.
+//
+// Every field of V tagged with the name of an attribute variable becomes an
+// attribute, e.g.,
+//
+//	type Vertex struct {
+//		Position [3]float32 `gogl:"a_position"`
+//		Color    [4]uint8   `gogl:"a_color,normalized"`
+//	}
+//
+// Fields without a tag, or tagged with "-", are skipped, but still count
+// towards the offsets of the following fields and the stride. Tagged fields
+// must be of type float32, int8, uint8, int16, uint16, int32 or uint32, or an
+// array of 1 to 4 of them, e.g., [3]float32 or mgl32.Vec3. The "normalized"
+// option sets VertexAttribute.Normalized.
+func VertexLayoutOf[V any]() (VertexLayout, error) {
+	var vertex V
+	t := reflect.TypeOf(vertex)
+	if t == nil || t.Kind() != reflect.Struct {
+		return VertexLayout{}, fmt.Errorf("gogl: vertex type %v is not a struct", t)
+	}
+	layout := VertexLayout{Stride: int32(t.Size())}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("gogl")
+		if !ok || tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			return VertexLayout{}, fmt.Errorf("gogl: field %v.%s has no attribute name", t, field.Name)
+		}
+		attribute := VertexAttribute{
+			Name:   name,
+			Offset: int(field.Offset),
+		}
+		switch options {
+		case "":
+		case "normalized":
+			attribute.Normalized = true
+		default:
+			return VertexLayout{}, fmt.Errorf("gogl: field %v.%s has unknown option %q", t, field.Name, options)
+		}
+		components, xtype, ok := attributeType(field.Type)
+		if !ok {
+			return VertexLayout{}, fmt.Errorf("gogl: field %v.%s has unsupported type %v", t, field.Name, field.Type)
+		}
+		attribute.Components = components
+		attribute.Type = xtype
+		layout.Attributes = append(layout.Attributes, attribute)
+	}
+	return layout, nil
+}
+
+// attributeType returns the number and type of the components of an attribute
+// stored as a value of type t.
+func attributeType(t reflect.Type) (int32, GLEnum, bool) {
+	components := int32(1)
+	if t.Kind() == reflect.Array {
+		if t.Len() < 1 || t.Len() > 4 {
+			return 0, 0, false
+		}
+		components = int32(t.Len())
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Float32:
+		return components, GLFloat32, true
+	case reflect.Int8:
+		return components, GLInt8, true
+	case reflect.Uint8:
+		return components, GLUInt8, true
+	case reflect.Int16:
+		return components, GLInt16, true
+	case reflect.Uint16:
+		return components, GLUInt16, true
+	case reflect.Int32:
+		return components, GLInt32, true
+	case reflect.Uint32:
+		return components, GLUInt32, true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,102 @@
+package gogl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestVertexLayoutOf(t *testing.T) {
+	type vertex struct {
+		Position [3]float32 `gogl:"a_position"`
+		Flags    uint8
+		Color    [4]uint8 `gogl:"a_color,normalized"`
+		Skipped  int16    `gogl:"-"`
+		Index    int16    `gogl:"a_index"`
+		Weight   float32  `gogl:"a_weight"`
+	}
+	layout, err := VertexLayoutOf[vertex]()
+	if err != nil {
+		t.Fatalf("VertexLayoutOf() error = %v", err)
+	}
+	want := VertexLayout{
+		Attributes: []VertexAttribute{
+			{Name: "a_position", Components: 3, Type: GLFloat32, Offset: 0},
+			{Name: "a_color", Components: 4, Type: GLUInt8, Normalized: true, Offset: 13},
+			{Name: "a_index", Components: 1, Type: GLInt16, Offset: 20},
+			{Name: "a_weight", Components: 1, Type: GLFloat32, Offset: 24},
+		},
+		Stride: 28,
+	}
+	if !reflect.DeepEqual(layout, want) {
+		t.Errorf("VertexLayoutOf() = %+v, want %+v", layout, want)
+	}
+}
+
+func TestVertexLayoutOfPadding(t *testing.T) {
+	// The stride includes the padding after Color, which aligns the next
+	// vertex for Position.
+	type vertex struct {
+		Position [2]float32 `gogl:"a_position"`
+		Color    [3]uint8   `gogl:"a_color,normalized"`
+	}
+	layout, err := VertexLayoutOf[vertex]()
+	if err != nil {
+		t.Fatalf("VertexLayoutOf() error = %v", err)
+	}
+	if layout.Stride != 12 || layout.Attributes[1].Offset != 8 {
+		t.Errorf("VertexLayoutOf() = %+v, want a stride of 12 and a_color at offset 8", layout)
+	}
+}
+
+func TestVertexLayoutOfInvalid(t *testing.T) {
+	type noName struct {
+		Position [3]float32 `gogl:",normalized"`
+	}
+	type badOption struct {
+		Position [3]float32 `gogl:"a_position,flat"`
+	}
+	type badType struct {
+		Position [3]float64 `gogl:"a_position"`
+	}
+	type tooManyComponents struct {
+		Matrix [16]float32 `gogl:"a_matrix"`
+	}
+	tests := []struct {
+		name   string
+		layout func() (VertexLayout, error)
+		err    string
+	}{
+		{"not a struct", VertexLayoutOf[[3]float32], "vertex type [3]float32 is not a struct"},
+		{"interface", VertexLayoutOf[any], "vertex type <nil> is not a struct"},
+		{"no name", VertexLayoutOf[noName], "field gogl.noName.Position has no attribute name"},
+		{"bad option", VertexLayoutOf[badOption], `field gogl.badOption.Position has unknown option "flat"`},
+		{"bad type", VertexLayoutOf[badType], "field gogl.badType.Position has unsupported type [3]float64"},
+		{"too many components", VertexLayoutOf[tooManyComponents], "has unsupported type [16]float32"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			layout, err := test.layout()
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("VertexLayoutOf() = %+v, %v, want error containing %q", layout, err, test.err)
+			}
+		})
+	}
+}
+
+func TestNewVertexLayout(t *testing.T) {
+	layout := NewVertexLayout(
+		VertexAttribute{Name: "a_position", Components: 3, Type: GLFloat32},
+		VertexAttribute{Name: "a_color", Components: 4, Type: GLUInt8, Normalized: true},
+		VertexAttribute{Name: "a_uv", Components: 2, Type: GLUInt16, Normalized: true},
+	)
+	want := []int{0, 12, 16}
+	for i, attribute := range layout.Attributes {
+		if attribute.Offset != want[i] {
+			t.Errorf("Attributes[%d].Offset = %d, want %d", i, attribute.Offset, want[i])
+		}
+	}
+	if layout.Stride != 20 {
+		t.Errorf("Stride = %d, want 20", layout.Stride)
+	}
+}